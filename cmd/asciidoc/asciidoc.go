@@ -11,8 +11,8 @@ import (
 // NewCommand returns a new cobra.Command for 'asciidoc' formatter
 func NewCommand(config *cli.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Args:        cobra.ExactArgs(1),
-		Use:         "asciidoc [PATH]",
+		Args:        cobra.MinimumNArgs(1),
+		Use:         "asciidoc [PATH]...",
 		Aliases:     []string{"adoc"},
 		Short:       "Generate AsciiDoc of inputs and outputs",
 		Annotations: cli.Annotations("asciidoc"),
@@ -23,7 +23,11 @@ func NewCommand(config *cli.Config) *cobra.Command {
 	// flags
 	cmd.PersistentFlags().BoolVar(&config.Settings.Required, "required", true, "show Required column or section")
 	cmd.PersistentFlags().BoolVar(&config.Settings.Sensitive, "sensitive", true, "show Sensitive column or section")
+	cmd.PersistentFlags().BoolVar(&config.Settings.DefaultHCL, "default-hcl", false, "render default values in HCL syntax instead of JSON")
+	cmd.PersistentFlags().BoolVar(&config.Settings.DefaultSource, "default-source", false, "render default values as written in HCL source")
 	cmd.PersistentFlags().IntVar(&config.Settings.Indent, "indent", 2, "indention level of AsciiDoc sections [1, 2, 3, 4, 5]")
+	cmd.PersistentFlags().StringVar(&config.Settings.ModuleSource, "module-source", "", "source of the module in Usage section, local path of the module if empty")
+	cmd.PersistentFlags().StringVar(&config.Settings.ModuleVersion, "module-version", "", "version of the module in Usage section, omitted if empty")
 
 	// deprecation
 	cmd.PersistentFlags().BoolVar(&config.Settings.Deprecated.NoRequired, "no-required", false, "do not show \"Required\" column or section")
@@ -0,0 +1,31 @@
+package html
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/internal/cli"
+)
+
+// NewCommand returns a new cobra.Command for 'html' formatter
+func NewCommand(config *cli.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:        cobra.MinimumNArgs(1),
+		Use:         "html [PATH]...",
+		Short:       "Generate HTML page of inputs and outputs",
+		Annotations: cli.Annotations("html"),
+		PreRunE:     cli.PreRunEFunc(config),
+		RunE:        cli.RunEFunc(config),
+	}
+
+	// flags
+	cmd.PersistentFlags().BoolVar(&config.Settings.Required, "required", true, "show Required column")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Sensitive, "sensitive", true, "show Sensitive column")
+	cmd.PersistentFlags().BoolVar(&config.Settings.DefaultHCL, "default-hcl", false, "render default values in HCL syntax instead of JSON")
+	cmd.PersistentFlags().BoolVar(&config.Settings.DefaultSource, "default-source", false, "render default values as written in HCL source")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Fragment, "fragment", false, "render only the content to embed it in another page (default false)")
+	cmd.PersistentFlags().IntVar(&config.Settings.Indent, "indent", 2, "level of HTML section headings [1, 2, 3, 4, 5]")
+	cmd.PersistentFlags().StringVar(&config.Settings.ModuleSource, "module-source", "", "source of the module in Usage section, local path of the module if empty")
+	cmd.PersistentFlags().StringVar(&config.Settings.ModuleVersion, "module-version", "", "version of the module in Usage section, omitted if empty")
+
+	return cmd
+}
@@ -9,8 +9,8 @@ import (
 // NewCommand returns a new cobra.Command for 'json' formatter
 func NewCommand(config *cli.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Args:        cobra.ExactArgs(1),
-		Use:         "json [PATH]",
+		Args:        cobra.MinimumNArgs(1),
+		Use:         "json [PATH]...",
 		Short:       "Generate JSON of inputs and outputs",
 		Annotations: cli.Annotations("json"),
 		PreRunE:     cli.PreRunEFunc(config),
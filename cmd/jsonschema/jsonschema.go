@@ -0,0 +1,20 @@
+package jsonschema
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/internal/cli"
+)
+
+// NewCommand returns a new cobra.Command for 'json-schema' formatter
+func NewCommand(config *cli.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:        cobra.MinimumNArgs(1),
+		Use:         "json-schema [PATH]...",
+		Short:       "Generate JSON Schema of inputs",
+		Annotations: cli.Annotations("json-schema"),
+		PreRunE:     cli.PreRunEFunc(config),
+		RunE:        cli.RunEFunc(config),
+	}
+	return cmd
+}
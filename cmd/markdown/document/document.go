@@ -9,8 +9,8 @@ import (
 // NewCommand returns a new cobra.Command for 'markdown document' formatter
 func NewCommand(config *cli.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Args:        cobra.ExactArgs(1),
-		Use:         "document [PATH]",
+		Args:        cobra.MinimumNArgs(1),
+		Use:         "document [PATH]...",
 		Aliases:     []string{"doc"},
 		Short:       "Generate Markdown document of inputs and outputs",
 		Annotations: cli.Annotations("markdown document"),
@@ -11,8 +11,8 @@ import (
 // NewCommand returns a new cobra.Command for 'markdown' formatter
 func NewCommand(config *cli.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Args:        cobra.ExactArgs(1),
-		Use:         "markdown [PATH]",
+		Args:        cobra.MinimumNArgs(1),
+		Use:         "markdown [PATH]...",
 		Aliases:     []string{"md"},
 		Short:       "Generate Markdown of inputs and outputs",
 		Annotations: cli.Annotations("markdown"),
@@ -24,7 +24,11 @@ func NewCommand(config *cli.Config) *cobra.Command {
 	cmd.PersistentFlags().BoolVar(&config.Settings.Required, "required", true, "show Required column or section")
 	cmd.PersistentFlags().BoolVar(&config.Settings.Sensitive, "sensitive", true, "show Sensitive column or section")
 	cmd.PersistentFlags().BoolVar(&config.Settings.Escape, "escape", true, "escape special characters")
+	cmd.PersistentFlags().BoolVar(&config.Settings.DefaultHCL, "default-hcl", false, "render default values in HCL syntax instead of JSON")
+	cmd.PersistentFlags().BoolVar(&config.Settings.DefaultSource, "default-source", false, "render default values as written in HCL source")
 	cmd.PersistentFlags().IntVar(&config.Settings.Indent, "indent", 2, "indention level of Markdown sections [1, 2, 3, 4, 5]")
+	cmd.PersistentFlags().StringVar(&config.Settings.ModuleSource, "module-source", "", "source of the module in Usage section, local path of the module if empty")
+	cmd.PersistentFlags().StringVar(&config.Settings.ModuleVersion, "module-version", "", "version of the module in Usage section, omitted if empty")
 
 	// deprecation
 	cmd.PersistentFlags().BoolVar(&config.Settings.Deprecated.NoRequired, "no-required", false, "do not show \"Required\" column or section")
@@ -9,8 +9,8 @@ import (
 // NewCommand returns a new cobra.Command for 'markdown table' formatter
 func NewCommand(config *cli.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Args:        cobra.ExactArgs(1),
-		Use:         "table [PATH]",
+		Args:        cobra.MinimumNArgs(1),
+		Use:         "table [PATH]...",
 		Aliases:     []string{"tbl"},
 		Short:       "Generate Markdown tables of inputs and outputs",
 		Annotations: cli.Annotations("markdown table"),
@@ -9,8 +9,8 @@ import (
 // NewCommand returns a new cobra.Command for pretty formatter
 func NewCommand(config *cli.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Args:        cobra.ExactArgs(1),
-		Use:         "pretty [PATH]",
+		Args:        cobra.MinimumNArgs(1),
+		Use:         "pretty [PATH]...",
 		Short:       "Generate colorized pretty of inputs and outputs",
 		Annotations: cli.Annotations("pretty"),
 		PreRunE:     cli.PreRunEFunc(config),
@@ -19,6 +19,8 @@ func NewCommand(config *cli.Config) *cobra.Command {
 
 	// flags
 	cmd.PersistentFlags().BoolVar(&config.Settings.Color, "color", true, "colorize printed result")
+	cmd.PersistentFlags().BoolVar(&config.Settings.DefaultHCL, "default-hcl", false, "render default values in HCL syntax instead of JSON")
+	cmd.PersistentFlags().BoolVar(&config.Settings.DefaultSource, "default-source", false, "render default values as written in HCL source")
 
 	// deprecation
 	cmd.PersistentFlags().BoolVar(&config.Settings.Deprecated.NoColor, "no-color", false, "do not colorize printed result")
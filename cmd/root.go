@@ -7,11 +7,16 @@ import (
 
 	"github.com/terraform-docs/terraform-docs/cmd/asciidoc"
 	"github.com/terraform-docs/terraform-docs/cmd/completion"
+	"github.com/terraform-docs/terraform-docs/cmd/html"
 	"github.com/terraform-docs/terraform-docs/cmd/json"
+	"github.com/terraform-docs/terraform-docs/cmd/jsonschema"
 	"github.com/terraform-docs/terraform-docs/cmd/markdown"
 	"github.com/terraform-docs/terraform-docs/cmd/pretty"
+	"github.com/terraform-docs/terraform-docs/cmd/rst"
+	"github.com/terraform-docs/terraform-docs/cmd/terragrunt"
 	"github.com/terraform-docs/terraform-docs/cmd/tfvars"
 	"github.com/terraform-docs/terraform-docs/cmd/toml"
+	"github.com/terraform-docs/terraform-docs/cmd/usage"
 	"github.com/terraform-docs/terraform-docs/cmd/version"
 	"github.com/terraform-docs/terraform-docs/cmd/xml"
 	"github.com/terraform-docs/terraform-docs/cmd/yaml"
@@ -32,8 +37,8 @@ func Execute() error {
 func NewCommand() *cobra.Command {
 	config := cli.DefaultConfig()
 	cmd := &cobra.Command{
-		Args:          cobra.MaximumNArgs(1),
-		Use:           "terraform-docs [PATH]",
+		Args:          cobra.ArbitraryArgs,
+		Use:           "terraform-docs [PATH]...",
 		Short:         "A utility to generate documentation from Terraform modules in various output formats",
 		Long:          "A utility to generate documentation from Terraform modules in various output formats",
 		Version:       version.Full(),
@@ -47,8 +52,8 @@ func NewCommand() *cobra.Command {
 	// flags
 	cmd.PersistentFlags().StringVarP(&config.File, "config", "c", ".terraform-docs.yml", "config file name")
 
-	cmd.PersistentFlags().StringSliceVar(&config.Sections.Show, "show", []string{}, "show section [header, inputs, outputs, providers, requirements]")
-	cmd.PersistentFlags().StringSliceVar(&config.Sections.Hide, "hide", []string{}, "hide section [header, inputs, outputs, providers, requirements]")
+	cmd.PersistentFlags().StringSliceVar(&config.Sections.Show, "show", []string{}, "show section [footer, header, inputs, locals, modules, outputs, providers, requirements, resources, usage]")
+	cmd.PersistentFlags().StringSliceVar(&config.Sections.Hide, "hide", []string{}, "hide section [footer, header, inputs, locals, modules, outputs, providers, requirements, resources, usage]")
 	cmd.PersistentFlags().BoolVar(&config.Sections.ShowAll, "show-all", true, "show all sections")
 	cmd.PersistentFlags().BoolVar(&config.Sections.HideAll, "hide-all", false, "hide all sections (default false)")
 
@@ -57,6 +62,26 @@ func NewCommand() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(&config.Sort.By.Type, "sort-by-type", false, "sort items by type of them (default false)")
 
 	cmd.PersistentFlags().StringVar(&config.HeaderFrom, "header-from", "main.tf", "relative path of a file to read header from")
+	cmd.PersistentFlags().StringVar(&config.HeaderStopAt, "header-stop-at", "blank-line", "where to stop reading header or footer from comments of .tf file [blank-line, non-comment]")
+	cmd.PersistentFlags().StringVar(&config.FooterFrom, "footer-from", "", "relative path of a file to read footer from (default \"\")")
+
+	cmd.PersistentFlags().BoolVar(&config.Recursive.Enabled, "recursive", false, "update submodules recursively (default false)")
+	cmd.PersistentFlags().StringVar(&config.Recursive.Path, "recursive-path", "modules", "submodules path to recursively update")
+
+	cmd.PersistentFlags().StringVar(&config.Output.File, "output-file", "", "file path to insert output into (default \"\")")
+	cmd.PersistentFlags().StringVar(&config.Output.MarkerBegin, "output-marker-begin", "", "begin marker of the block of output in output file (default \"<!-- BEGIN_TF_DOCS -->\")")
+	cmd.PersistentFlags().StringVar(&config.Output.MarkerEnd, "output-marker-end", "", "end marker of the block of output in output file (default \"<!-- END_TF_DOCS -->\")")
+
+	cmd.PersistentFlags().BoolVar(&config.Output.Check, "output-check", false, "check if output file is up to date, without modifying it (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Output.Diff, "diff", false, "show unified diff of changes to output file, without modifying it (default false)")
+
+	cmd.PersistentFlags().StringVar(&config.ProviderLink, "provider-link", "", "link template to documentation of providers [placeholders: {hostname}, {namespace}, {type}] (default \"\")")
+	cmd.PersistentFlags().StringVar(&config.ResourceLink, "resource-link", "", "link template to documentation of resources [placeholders: {provider}, {kind}, {type}] (default \"\")")
+
+	cmd.PersistentFlags().BoolVar(&config.Strict, "strict", false, "fail if any warnings are found while loading the module (default false)")
+	cmd.PersistentFlags().BoolVar(&config.Verbose, "verbose", false, "print all diagnostics of loading the module in detail (default false)")
+
+	cmd.PersistentFlags().IntVar(&config.Parallelism, "parallelism", 10, "number of modules to process concurrently, when multiple paths are provided")
 
 	cmd.PersistentFlags().BoolVar(&config.OutputValues.Enabled, "output-values", false, "inject output values into outputs (default false)")
 	cmd.PersistentFlags().StringVar(&config.OutputValues.From, "output-values-from", "", "inject output values from file into outputs (default \"\")")
@@ -78,11 +103,16 @@ func NewCommand() *cobra.Command {
 
 	// formatter subcommands
 	cmd.AddCommand(asciidoc.NewCommand(config))
+	cmd.AddCommand(html.NewCommand(config))
 	cmd.AddCommand(json.NewCommand(config))
+	cmd.AddCommand(jsonschema.NewCommand(config))
 	cmd.AddCommand(markdown.NewCommand(config))
 	cmd.AddCommand(pretty.NewCommand(config))
+	cmd.AddCommand(rst.NewCommand(config))
+	cmd.AddCommand(terragrunt.NewCommand(config))
 	cmd.AddCommand(tfvars.NewCommand(config))
 	cmd.AddCommand(toml.NewCommand(config))
+	cmd.AddCommand(usage.NewCommand(config))
 	cmd.AddCommand(xml.NewCommand(config))
 	cmd.AddCommand(yaml.NewCommand(config))
 
@@ -0,0 +1,21 @@
+package document
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/internal/cli"
+)
+
+// NewCommand returns a new cobra.Command for 'rst document' formatter
+func NewCommand(config *cli.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:        cobra.MinimumNArgs(1),
+		Use:         "document [PATH]...",
+		Aliases:     []string{"doc"},
+		Short:       "Generate reStructuredText document of inputs and outputs",
+		Annotations: cli.Annotations("rst document"),
+		PreRunE:     cli.PreRunEFunc(config),
+		RunE:        cli.RunEFunc(config),
+	}
+	return cmd
+}
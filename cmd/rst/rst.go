@@ -0,0 +1,37 @@
+package rst
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/cmd/rst/document"
+	"github.com/terraform-docs/terraform-docs/cmd/rst/table"
+	"github.com/terraform-docs/terraform-docs/internal/cli"
+)
+
+// NewCommand returns a new cobra.Command for 'rst' formatter
+func NewCommand(config *cli.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:        cobra.MinimumNArgs(1),
+		Use:         "rst [PATH]...",
+		Short:       "Generate reStructuredText of inputs and outputs",
+		Annotations: cli.Annotations("rst"),
+		PreRunE:     cli.PreRunEFunc(config),
+		RunE:        cli.RunEFunc(config),
+	}
+
+	// flags
+	cmd.PersistentFlags().BoolVar(&config.Settings.Required, "required", true, "show Required column or section")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Sensitive, "sensitive", true, "show Sensitive column or section")
+	cmd.PersistentFlags().BoolVar(&config.Settings.Escape, "escape", true, "escape special characters")
+	cmd.PersistentFlags().BoolVar(&config.Settings.DefaultHCL, "default-hcl", false, "render default values in HCL syntax instead of JSON")
+	cmd.PersistentFlags().BoolVar(&config.Settings.DefaultSource, "default-source", false, "render default values as written in HCL source")
+	cmd.PersistentFlags().IntVar(&config.Settings.Indent, "indent", 2, "indention level of reStructuredText sections [1, 2, 3, 4, 5]")
+	cmd.PersistentFlags().StringVar(&config.Settings.ModuleSource, "module-source", "", "source of the module in Usage section, local path of the module if empty")
+	cmd.PersistentFlags().StringVar(&config.Settings.ModuleVersion, "module-version", "", "version of the module in Usage section, omitted if empty")
+
+	// subcommands
+	cmd.AddCommand(document.NewCommand(config))
+	cmd.AddCommand(table.NewCommand(config))
+
+	return cmd
+}
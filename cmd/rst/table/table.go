@@ -0,0 +1,21 @@
+package table
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/internal/cli"
+)
+
+// NewCommand returns a new cobra.Command for 'rst table' formatter
+func NewCommand(config *cli.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:        cobra.MinimumNArgs(1),
+		Use:         "table [PATH]...",
+		Aliases:     []string{"tbl"},
+		Short:       "Generate reStructuredText tables of inputs and outputs",
+		Annotations: cli.Annotations("rst table"),
+		PreRunE:     cli.PreRunEFunc(config),
+		RunE:        cli.RunEFunc(config),
+	}
+	return cmd
+}
@@ -0,0 +1,25 @@
+package terragrunt
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/internal/cli"
+)
+
+// NewCommand returns a new cobra.Command for 'terragrunt' formatter
+func NewCommand(config *cli.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:        cobra.MinimumNArgs(1),
+		Use:         "terragrunt [PATH]...",
+		Short:       "Generate Terragrunt configuration of inputs",
+		Annotations: cli.Annotations("terragrunt"),
+		PreRunE:     cli.PreRunEFunc(config),
+		RunE:        cli.RunEFunc(config),
+	}
+
+	// flags
+	cmd.PersistentFlags().StringVar(&config.Settings.ModuleSource, "module-source", "", "source of the module in terraform block (e.g. git::https://example.com/modules.git//vpc?ref=v1.0.0)")
+	cmd.PersistentFlags().BoolVar(&config.Settings.DefaultSource, "default-source", false, "render default values as written in HCL source")
+
+	return cmd
+}
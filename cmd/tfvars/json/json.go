@@ -9,8 +9,8 @@ import (
 // NewCommand returns a new cobra.Command for 'tfvars json' formatter
 func NewCommand(config *cli.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Args:        cobra.ExactArgs(1),
-		Use:         "json [PATH]",
+		Args:        cobra.MinimumNArgs(1),
+		Use:         "json [PATH]...",
 		Short:       "Generate JSON format of terraform.tfvars of inputs",
 		Annotations: cli.Annotations("tfvars json"),
 		PreRunE:     cli.PreRunEFunc(config),
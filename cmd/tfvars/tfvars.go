@@ -11,8 +11,8 @@ import (
 // NewCommand returns a new cobra.Command for 'tfvars' formatter
 func NewCommand(config *cli.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Args:        cobra.ExactArgs(1),
-		Use:         "tfvars [PATH]",
+		Args:        cobra.MinimumNArgs(1),
+		Use:         "tfvars [PATH]...",
 		Short:       "Generate terraform.tfvars of inputs",
 		Annotations: cli.Annotations("tfvars"),
 	}
@@ -9,8 +9,8 @@ import (
 // NewCommand returns a new cobra.Command for 'toml' formatter
 func NewCommand(config *cli.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Args:        cobra.ExactArgs(1),
-		Use:         "toml [PATH]",
+		Args:        cobra.MinimumNArgs(1),
+		Use:         "toml [PATH]...",
 		Short:       "Generate TOML of inputs and outputs",
 		Annotations: cli.Annotations("toml"),
 		PreRunE:     cli.PreRunEFunc(config),
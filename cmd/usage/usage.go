@@ -0,0 +1,26 @@
+package usage
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/terraform-docs/terraform-docs/internal/cli"
+)
+
+// NewCommand returns a new cobra.Command for 'usage' formatter
+func NewCommand(config *cli.Config) *cobra.Command {
+	cmd := &cobra.Command{
+		Args:        cobra.MinimumNArgs(1),
+		Use:         "usage [PATH]...",
+		Short:       "Generate example usage of the module in HCL",
+		Annotations: cli.Annotations("usage"),
+		PreRunE:     cli.PreRunEFunc(config),
+		RunE:        cli.RunEFunc(config),
+	}
+
+	// flags
+	cmd.PersistentFlags().StringVar(&config.Settings.ModuleSource, "module-source", "", "source of the module in module block, local path of the module if empty (e.g. terraform-aws-modules/vpc/aws)")
+	cmd.PersistentFlags().StringVar(&config.Settings.ModuleVersion, "module-version", "", "version of the module in module block, omitted if empty")
+	cmd.PersistentFlags().BoolVar(&config.Settings.DefaultSource, "default-source", false, "render default values as written in HCL source")
+
+	return cmd
+}
@@ -9,8 +9,8 @@ import (
 // NewCommand returns a new cobra.Command for 'xml' formatter
 func NewCommand(config *cli.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Args:        cobra.ExactArgs(1),
-		Use:         "xml [PATH]",
+		Args:        cobra.MinimumNArgs(1),
+		Use:         "xml [PATH]...",
 		Short:       "Generate XML of inputs and outputs",
 		Annotations: cli.Annotations("xml"),
 		PreRunE:     cli.PreRunEFunc(config),
@@ -9,8 +9,8 @@ import (
 // NewCommand returns a new cobra.Command for 'yaml' formatter
 func NewCommand(config *cli.Config) *cobra.Command {
 	cmd := &cobra.Command{
-		Args:        cobra.ExactArgs(1),
-		Use:         "yaml [PATH]",
+		Args:        cobra.MinimumNArgs(1),
+		Use:         "yaml [PATH]...",
 		Short:       "Generate YAML of inputs and outputs",
 		Annotations: cli.Annotations("yaml"),
 		PreRunE:     cli.PreRunEFunc(config),
@@ -42,10 +42,21 @@ terraform {
   required_version = ">= 0.12"
   required_providers {
     random = ">= 2.2.0"
-    aws    = ">= 2.15.0"
+    aws = {
+      source                = "hashicorp/aws"
+      version               = ">= 2.15.0"
+      configuration_aliases = [aws.ident]
+    }
+    tls = {
+      source = "example.com/foo/tls"
+    }
   }
 }
 
+provider "aws" {
+  alias = "replica"
+}
+
 resource "tls_private_key" "baz" {}
 
 data "aws_caller_identity" "current" {
@@ -57,3 +68,26 @@ data "aws_caller_identity" "ident" {
 }
 
 resource "null_resource" "foo" {}
+
+module "foo" {
+  source  = "bar"
+  version = "1.2.3"
+}
+
+module "bar" {
+  source  = "baz"
+  version = "4.5.6"
+}
+
+module "baz" {
+  source = "./modules/baz"
+}
+
+locals {
+  # Name prefix of all the resources
+  prefix = "foo-${var.string-1}"
+
+  tags = {
+    Name = local.prefix
+  }
+}
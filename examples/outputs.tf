@@ -3,12 +3,14 @@ output unquoted {
   value       = ""
 }
 
+// @deprecated
 output "output-2" {
   description = "It's output number two."
   value       = "2"
 }
 
 // It's output number one.
+// @example module.foo.output-1
 output "output-1" {
   value = "1"
 }
@@ -16,4 +18,5 @@ output "output-1" {
 output "output-0.12" {
   value       = join(",", var.list-3)
   description = "terraform 0.12 only"
+  sensitive   = true
 }
@@ -10,10 +10,13 @@ variable "bool-2" {
 }
 
 // It's bool number one.
+// @since 1.2.0
+// @group booleans
 variable "bool-1" {
   default = true
 }
 
+# @deprecated use string-1 instead
 variable "string-3" {
   default = ""
 }
@@ -21,11 +24,20 @@ variable "string-3" {
 variable "string-2" {
   description = "It's string number two."
   type        = "string"
+  sensitive   = true
 }
 
 // It's string number one.
 variable "string-1" {
   default = "bar"
+
+  validation {
+    condition = contains(
+      ["foo", "bar"],
+      var.string-1,
+    )
+    error_message = "The string-1 value must be either \"foo\" or \"bar\"."
+  }
 }
 
 variable "string-special-chars" {
@@ -40,6 +52,11 @@ variable "number-3" {
 variable "number-4" {
   type    = number
   default = 15.75
+
+  validation {
+    condition     = var.number-4 > 0 && var.number-4 < 100
+    error_message = "The number-4 value must be between 0 and 100."
+  }
 }
 
 variable "number-2" {
@@ -113,10 +130,11 @@ EOD
 
 variable "long_type" {
   type = object({
-    name = string,
+    name = string, # name of the resource
+    # settings of foo
     foo  = object({ foo = string, bar = string }),
     bar  = object({ foo = string, bar = string }),
-    fizz = list(string),
+    fizz = optional(list(string), []), # list of fizz items
     buzz = list(string)
   })
   default = {
@@ -136,6 +154,11 @@ variable "long_type" {
 This description is itself markdown.
 
 It spans over multiple lines.
+
+@example
+{
+  name = "hello"
+}
 EOF
 }
 
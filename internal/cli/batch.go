@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+// batch generates documentation of multiple modules concurrently, with
+// at most 'config.Parallelism' modules being processed at the same time.
+// Modules which don't have their own config file share the same Config,
+// and hence the same formatter, with the root one.
+type batch struct {
+	config    *Config
+	formatter string
+	modules   []string
+
+	// root module (if any) always uses 'config' as is, e.g. in
+	// recursive mode its config file has already been read.
+	root string
+}
+
+type result struct {
+	dir string
+	err error
+}
+
+// run processes all the modules and prints a summary at the end. It
+// doesn't stop at the first failure, instead errors of all the modules
+// are collected and reported together.
+func (b *batch) run() error {
+	// config of each module is read upfront, to make sure Config of the
+	// modules without config file is shared between all of them.
+	configs := make([]*Config, len(b.modules))
+	results := make([]result, len(b.modules))
+	for i, dir := range b.modules {
+		results[i].dir = dir
+		configs[i], results[i].err = b.moduleConfig(dir)
+	}
+
+	workers := b.config.Parallelism
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > len(b.modules) {
+		workers = len(b.modules)
+	}
+
+	jobs := make(chan int)
+	wg := sync.WaitGroup{}
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			g := newGenerator()
+			for i := range jobs {
+				results[i].err = g.generate(configs[i], b.modules[i])
+			}
+		}()
+	}
+
+	for i := range b.modules {
+		if results[i].err == nil {
+			jobs <- i
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return summary(results)
+}
+
+// moduleConfig returns Config of the module in 'dir'.
+func (b *batch) moduleConfig(dir string) (*Config, error) {
+	if dir == b.root {
+		return b.config, nil
+	}
+	config, err := moduleConfig(b.config, dir, b.formatter)
+	if err != nil {
+		return nil, err
+	}
+	if len(b.modules) > 1 && config.Output.File == "" {
+		return nil, fmt.Errorf("value of '--output-file' is missing, it's required for multiple modules")
+	}
+	return config, nil
+}
+
+// summary prints the summary of processed modules to stderr, to not mix it
+// with generated output of the modules printed to stdout, and returns an
+// error if any of them has failed.
+func summary(results []result) error {
+	failed := 0
+	for _, r := range results {
+		if r.err != nil {
+			failed++
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "\nSummary: %d modules, %d succeeded, %d failed\n", len(results), len(results)-failed, failed)
+	for _, r := range results {
+		if r.err != nil {
+			fmt.Fprintf(os.Stderr, "  - %s: %v\n", r.dir, r.err)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d modules failed", failed, len(results))
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindPaths(t *testing.T) {
+	modules := filepath.Join("testdata", "recursive", "modules")
+	tests := []struct {
+		name     string
+		args     []string
+		expected []string
+	}{
+		{
+			name:     "single path",
+			args:     []string{modules},
+			expected: []string{modules},
+		},
+		{
+			name: "multiple paths",
+			args: []string{filepath.Join(modules, "foo"), filepath.Join(modules, "bar")},
+			expected: []string{
+				filepath.Join(modules, "foo"),
+				filepath.Join(modules, "bar"),
+			},
+		},
+		{
+			name: "glob pattern",
+			args: []string{filepath.Join(modules, "*")},
+			expected: []string{
+				filepath.Join(modules, "bar"),
+				filepath.Join(modules, "foo"),
+			},
+		},
+		{
+			name: "duplicated paths",
+			args: []string{filepath.Join(modules, "foo"), filepath.Join(modules, "f*")},
+			expected: []string{
+				filepath.Join(modules, "foo"),
+			},
+		},
+		{
+			name:     "non-existing path",
+			args:     []string{"noop"},
+			expected: []string{"noop"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual, err := findPaths(tt.args)
+
+			assert.Nil(err)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestBatchRun(t *testing.T) {
+	modules := filepath.Join("testdata", "recursive", "modules")
+	tests := []struct {
+		name        string
+		modules     []string
+		parallelism int
+		outputFile  string
+		wantErr     bool
+		errMsg      string
+	}{
+		{
+			name:        "all modules succeeded",
+			modules:     []string{filepath.Join(modules, "foo"), filepath.Join(modules, "bar")},
+			parallelism: 2,
+			outputFile:  "README.md",
+			wantErr:     false,
+			errMsg:      "",
+		},
+		{
+			name:        "one module failed",
+			modules:     []string{filepath.Join(modules, "foo"), "noop", filepath.Join(modules, "bar")},
+			parallelism: 1,
+			outputFile:  "README.md",
+			wantErr:     true,
+			errMsg:      "1 of 3 modules failed",
+		},
+		{
+			name:        "output file is missing",
+			modules:     []string{filepath.Join(modules, "foo"), filepath.Join(modules, "bar")},
+			parallelism: 10,
+			outputFile:  "",
+			wantErr:     true,
+			errMsg:      "2 of 2 modules failed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			config := DefaultConfig()
+			config.File = ".terraform-docs.yml"
+			config.Formatter = "markdown table"
+			config.Parallelism = tt.parallelism
+			config.Output.File = tt.outputFile
+			config.Output.Diff = true // make sure no file is written
+			config.process()
+
+			b := &batch{
+				config:    config,
+				formatter: "root",
+				modules:   tt.modules,
+			}
+
+			err := b.run()
+
+			if tt.wantErr {
+				assert.NotNil(err)
+				assert.Equal(tt.errMsg, err.Error())
+			} else {
+				assert.Nil(err)
+			}
+		})
+	}
+}
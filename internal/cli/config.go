@@ -2,6 +2,8 @@ package cli
 
 import (
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/terraform-docs/terraform-docs/internal/module"
 	"github.com/terraform-docs/terraform-docs/pkg/print"
@@ -14,6 +16,26 @@ type _sections struct {
 	NoProviders    bool
 	NoRequirements bool
 }
+
+type recursive struct {
+	Enabled bool   `yaml:"enabled"`
+	Path    string `yaml:"path"`
+}
+
+func defaultRecursive() recursive {
+	return recursive{
+		Enabled: false,
+		Path:    "modules",
+	}
+}
+
+func (r *recursive) validate() error {
+	if r.Enabled && r.Path == "" {
+		return fmt.Errorf("value of '--recursive-path' can't be empty")
+	}
+	return nil
+}
+
 type sections struct {
 	Show       []string  `yaml:"show"`
 	Hide       []string  `yaml:"hide"`
@@ -21,11 +43,16 @@ type sections struct {
 	HideAll    bool      `yaml:"hide-all"`
 	Deprecated _sections `yaml:"-"`
 
+	footer       bool `yaml:"-"`
 	header       bool `yaml:"-"`
 	inputs       bool `yaml:"-"`
+	locals       bool `yaml:"-"`
+	modules      bool `yaml:"-"`
 	outputs      bool `yaml:"-"`
 	providers    bool `yaml:"-"`
 	requirements bool `yaml:"-"`
+	resources    bool `yaml:"-"`
+	usage        bool `yaml:"-"`
 }
 
 func defaultSections() sections {
@@ -42,35 +69,48 @@ func defaultSections() sections {
 			NoRequirements: false,
 		},
 
+		footer:       false,
 		header:       false,
 		inputs:       false,
+		locals:       false,
+		modules:      false,
 		outputs:      false,
 		providers:    false,
 		requirements: false,
+		resources:    false,
+		usage:        false,
 	}
 }
 
+// optinSections are hidden by default, and are only shown when explicitly
+// asked for with '--show', regardless of '--show-all'.
+var optinSections = []string{"locals", "usage"}
+
+// usageFormatters are the formatters (and their aliases) which render 'usage'
+// section, i.e. the ones generating a document.
+var usageFormatters = []string{"adoc", "asciidoc", "html", "markdown", "md", "rst"}
+
 func (s *sections) validate() error {
-	items := []string{"header", "inputs", "outputs", "providers", "requirements"}
+	items := []string{"footer", "header", "inputs", "locals", "modules", "outputs", "providers", "requirements", "resources", "usage"}
 	for _, item := range s.Show {
-		switch item {
-		case items[0], items[1], items[2], items[3], items[4]:
-		default:
+		if !contains(items, item) {
 			return fmt.Errorf("'%s' is not a valid section", item)
 		}
 	}
 	for _, item := range s.Hide {
-		switch item {
-		case items[0], items[1], items[2], items[3], items[4]:
-		default:
+		if !contains(items, item) {
 			return fmt.Errorf("'%s' is not a valid section", item)
 		}
 	}
 	if s.ShowAll && s.HideAll {
 		return fmt.Errorf("'--show-all' and '--hide-all' can't be used together")
 	}
-	if s.ShowAll && len(s.Show) != 0 {
-		return fmt.Errorf("'--show-all' and '--show' can't be used together")
+	if s.ShowAll {
+		for _, item := range s.Show {
+			if !contains(optinSections, item) {
+				return fmt.Errorf("'--show-all' and '--show' can't be used together")
+			}
+		}
 	}
 	if s.HideAll && len(s.Hide) != 0 {
 		return fmt.Errorf("'--hide-all' and '--hide' can't be used together")
@@ -84,6 +124,9 @@ func (s *sections) validate() error {
 }
 
 func (s *sections) visibility(section string) bool {
+	if contains(optinSections, section) {
+		return contains(s.Show, section) && !contains(s.Hide, section)
+	}
 	if s.ShowAll && !s.HideAll {
 		for _, n := range s.Hide {
 			if n == section {
@@ -127,6 +170,65 @@ func (o *outputvalues) validate() error {
 	return nil
 }
 
+type output struct {
+	File        string `yaml:"file"`
+	MarkerBegin string `yaml:"marker-begin"`
+	MarkerEnd   string `yaml:"marker-end"`
+	Check       bool   `yaml:"check"`
+	Diff        bool   `yaml:"diff"`
+}
+
+func defaultOutput() output {
+	return output{
+		File:        "",
+		MarkerBegin: "",
+		MarkerEnd:   "",
+		Check:       false,
+		Diff:        false,
+	}
+}
+
+func (o *output) validate() error {
+	if o.File == "" {
+		if changedfs["output-file"] {
+			return fmt.Errorf("value of '--output-file' can't be empty")
+		}
+		if o.Check {
+			return fmt.Errorf("value of '--output-file' is missing, it's required by '--output-check'")
+		}
+		if o.Diff {
+			return fmt.Errorf("value of '--output-file' is missing, it's required by '--diff'")
+		}
+		return nil
+	}
+	begin, end := o.markers()
+	if begin == end {
+		return fmt.Errorf("'--output-marker-begin' and '--output-marker-end' can't be the same")
+	}
+	if strings.Contains(begin, "\n") || strings.Contains(end, "\n") {
+		return fmt.Errorf("value of '--output-marker-begin' and '--output-marker-end' must be a single line")
+	}
+	return nil
+}
+
+// markers returns begin and end markers of the block of generated content.
+// If not provided, default markers are returned based on the format of
+// output file (i.e. AsciiDoc comments for '.adoc' files and HTML comments
+// for everything else)
+func (o *output) markers() (string, string) {
+	begin, end := "<!-- BEGIN_TF_DOCS -->", "<!-- END_TF_DOCS -->"
+	if strings.HasSuffix(o.File, ".adoc") {
+		begin, end = "// BEGIN_TF_DOCS", "// END_TF_DOCS"
+	}
+	if o.MarkerBegin != "" {
+		begin = o.MarkerBegin
+	}
+	if o.MarkerEnd != "" {
+		end = o.MarkerEnd
+	}
+	return begin, end
+}
+
 type sortby struct {
 	Required bool `name:"required"`
 	Type     bool `name:"type"`
@@ -183,21 +285,31 @@ type _settings struct {
 	NoSensitive bool
 }
 type settings struct {
-	Color      bool      `yaml:"color"`
-	Escape     bool      `yaml:"escape"`
-	Indent     int       `yaml:"indent"`
-	Required   bool      `yaml:"required"`
-	Sensitive  bool      `yaml:"sensitive"`
-	Deprecated _settings `yaml:"-"`
+	Color         bool      `yaml:"color"`
+	DefaultHCL    bool      `yaml:"default-hcl"`
+	DefaultSource bool      `yaml:"default-source"`
+	Escape        bool      `yaml:"escape"`
+	Fragment      bool      `yaml:"fragment"`
+	Indent        int       `yaml:"indent"`
+	ModuleSource  string    `yaml:"module-source"`
+	ModuleVersion string    `yaml:"module-version"`
+	Required      bool      `yaml:"required"`
+	Sensitive     bool      `yaml:"sensitive"`
+	Deprecated    _settings `yaml:"-"`
 }
 
 func defaultSettings() settings {
 	return settings{
-		Color:     true,
-		Escape:    true,
-		Indent:    2,
-		Required:  true,
-		Sensitive: true,
+		Color:         true,
+		DefaultHCL:    false,
+		DefaultSource: false,
+		Escape:        true,
+		Fragment:      false,
+		Indent:        2,
+		ModuleSource:  "",
+		ModuleVersion: "",
+		Required:      true,
+		Sensitive:     true,
 		Deprecated: _settings{
 			NoColor:     false,
 			NoEscape:    false,
@@ -222,10 +334,19 @@ type Config struct {
 	File         string       `yaml:"-"`
 	Formatter    string       `yaml:"formatter"`
 	HeaderFrom   string       `yaml:"header-from"`
+	HeaderStopAt string       `yaml:"header-stop-at"`
+	FooterFrom   string       `yaml:"footer-from"`
+	Recursive    recursive    `yaml:"recursive"`
 	Sections     sections     `yaml:"sections"`
+	Output       output       `yaml:"output"`
 	OutputValues outputvalues `yaml:"output-values"`
+	ProviderLink string       `yaml:"provider-link"`
+	ResourceLink string       `yaml:"resource-link"`
+	Parallelism  int          `yaml:"-"`
 	Sort         sort         `yaml:"sort"`
 	Settings     settings     `yaml:"settings"`
+	Strict       bool         `yaml:"strict"`
+	Verbose      bool         `yaml:"verbose"`
 }
 
 // DefaultConfig returns new instance of Config with default values set
@@ -234,10 +355,19 @@ func DefaultConfig() *Config {
 		File:         "",
 		Formatter:    "",
 		HeaderFrom:   "main.tf",
+		HeaderStopAt: "blank-line",
+		FooterFrom:   "",
+		Recursive:    defaultRecursive(),
 		Sections:     defaultSections(),
+		Output:       defaultOutput(),
 		OutputValues: defaultOutputValues(),
+		ProviderLink: "",
+		ResourceLink: "",
+		Parallelism:  10,
 		Sort:         defaultSort(),
 		Settings:     defaultSettings(),
+		Strict:       false,
+		Verbose:      false,
 	}
 }
 
@@ -250,11 +380,16 @@ func (c *Config) process() {
 	if !c.Sections.ShowAll && !changedfs["hide-all"] {
 		c.Sections.HideAll = true
 	}
+	c.Sections.footer = c.Sections.visibility("footer")
 	c.Sections.header = c.Sections.visibility("header")
 	c.Sections.inputs = c.Sections.visibility("inputs")
+	c.Sections.locals = c.Sections.visibility("locals")
+	c.Sections.modules = c.Sections.visibility("modules")
 	c.Sections.outputs = c.Sections.visibility("outputs")
 	c.Sections.providers = c.Sections.visibility("providers")
 	c.Sections.requirements = c.Sections.visibility("requirements")
+	c.Sections.resources = c.Sections.visibility("resources")
+	c.Sections.usage = c.Sections.visibility("usage")
 
 	// sort
 	if !changedfs["sort"] && changedfs["no-sort"] {
@@ -287,11 +422,32 @@ func (c *Config) validate() error {
 	if c.HeaderFrom == "" {
 		return fmt.Errorf("value of '--header-from' can't be empty")
 	}
+	switch c.HeaderStopAt {
+	case "blank-line", "non-comment":
+	default:
+		return fmt.Errorf("value of '--header-stop-at' must be one of [blank-line, non-comment], got '%s'", c.HeaderStopAt)
+	}
+
+	// recursive
+	if err := c.Recursive.validate(); err != nil {
+		return err
+	}
 
 	// sections
 	if err := c.Sections.validate(); err != nil {
 		return err
 	}
+	if contains(c.Sections.Show, "usage") && !contains(usageFormatters, strings.Fields(c.Formatter)[0]) {
+		return fmt.Errorf("'usage' section is not supported by '%s'", c.Formatter)
+	}
+
+	// output
+	if err := c.Output.validate(); err != nil {
+		return err
+	}
+	if c.Recursive.Enabled && c.Output.File == "" {
+		return fmt.Errorf("value of '--output-file' is missing, it's required by '--recursive'")
+	}
 
 	// output values
 	if err := c.OutputValues.validate(); err != nil {
@@ -307,10 +463,23 @@ func (c *Config) validate() error {
 	if err := c.Settings.validate(); err != nil {
 		return err
 	}
+	if c.Formatter == "terragrunt" && c.Settings.ModuleSource == "" {
+		return fmt.Errorf("value of '--module-source' can't be empty, it's required by '%s'", c.Formatter)
+	}
 
 	return nil
 }
 
+// copy returns a copy of Config which doesn't share any underlying
+// slices with the original one
+func (c *Config) copy() *Config {
+	cc := *c
+	cc.Sections.Show = append([]string{}, c.Sections.Show...)
+	cc.Sections.Hide = append([]string{}, c.Sections.Hide...)
+	cc.Sort.ByList = append([]string{}, c.Sort.ByList...)
+	return &cc
+}
+
 // extract and build print.Settings and module.Options out of Config
 func (c *Config) extract() (*print.Settings, *module.Options) {
 	settings := print.NewSettings()
@@ -318,20 +487,41 @@ func (c *Config) extract() (*print.Settings, *module.Options) {
 
 	// header-from
 	options.HeaderFromFile = c.HeaderFrom
+	options.HeaderStopAt = c.HeaderStopAt
+
+	// footer-from
+	options.FooterFromFile = c.FooterFrom
 
 	// sections
+	settings.ShowFooter = c.Sections.footer
 	settings.ShowHeader = c.Sections.header
 	settings.ShowInputs = c.Sections.inputs
+	settings.ShowLocals = c.Sections.locals
+	settings.ShowModules = c.Sections.modules
 	settings.ShowOutputs = c.Sections.outputs
 	settings.ShowProviders = c.Sections.providers
 	settings.ShowRequirements = c.Sections.requirements
+	settings.ShowResources = c.Sections.resources
+	settings.ShowUsage = c.Sections.usage
 	options.ShowHeader = settings.ShowHeader
+	options.ShowFooter = settings.ShowFooter
 
 	// output values
 	settings.OutputValues = c.OutputValues.Enabled
 	options.OutputValues = c.OutputValues.Enabled
 	options.OutputValuesPath = c.OutputValues.From
 
+	// provider-link
+	options.ProviderLink = c.ProviderLink
+
+	// resource-link
+	options.ResourceLink = c.ResourceLink
+
+	// diagnostics
+	options.Strict = c.Strict
+	options.Verbose = c.Verbose
+	options.Diagnostics = os.Stderr
+
 	// sort
 	settings.SortByName = c.Sort.Enabled
 	settings.SortByRequired = c.Sort.Enabled && c.Sort.By.Required
@@ -341,8 +531,13 @@ func (c *Config) extract() (*print.Settings, *module.Options) {
 	options.SortBy.Type = settings.SortByType
 
 	// settings
+	settings.DefaultHCL = c.Settings.DefaultHCL
+	settings.DefaultSource = c.Settings.DefaultSource
 	settings.EscapeCharacters = c.Settings.Escape
+	settings.Fragment = c.Settings.Fragment
 	settings.IndentLevel = c.Settings.Indent
+	settings.ModuleSource = c.Settings.ModuleSource
+	settings.ModuleVersion = c.Settings.ModuleVersion
 	settings.ShowColor = c.Settings.Color
 	settings.ShowRequired = c.Settings.Required
 	settings.ShowSensitivity = c.Settings.Sensitive
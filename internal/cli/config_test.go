@@ -0,0 +1,169 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSectionsVisibility(t *testing.T) {
+	tests := []struct {
+		name     string
+		show     []string
+		hide     []string
+		showall  bool
+		hideall  bool
+		expected map[string]bool
+	}{
+		{
+			name:    "show all sections",
+			show:    []string{},
+			hide:    []string{},
+			showall: true,
+			hideall: false,
+			expected: map[string]bool{
+				"header": true,
+				"inputs": true,
+				"locals": false,
+				"usage":  false,
+			},
+		},
+		{
+			name:    "show all sections and opt-in section",
+			show:    []string{"locals"},
+			hide:    []string{"header"},
+			showall: true,
+			hideall: false,
+			expected: map[string]bool{
+				"header": false,
+				"inputs": true,
+				"locals": true,
+			},
+		},
+		{
+			name:    "hide all sections except opt-in section",
+			show:    []string{"locals"},
+			hide:    []string{},
+			showall: false,
+			hideall: true,
+			expected: map[string]bool{
+				"header": false,
+				"inputs": false,
+				"locals": true,
+				"usage":  false,
+			},
+		},
+		{
+			name:    "show all sections and usage section",
+			show:    []string{"usage"},
+			hide:    []string{},
+			showall: true,
+			hideall: false,
+			expected: map[string]bool{
+				"header": true,
+				"inputs": true,
+				"locals": false,
+				"usage":  true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			s := defaultSections()
+			s.Show = tt.show
+			s.Hide = tt.hide
+			s.ShowAll = tt.showall
+			s.HideAll = tt.hideall
+
+			for section, expected := range tt.expected {
+				assert.Equal(expected, s.visibility(section), section)
+			}
+		})
+	}
+}
+
+func TestSectionsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		show    []string
+		showall bool
+		wantErr bool
+	}{
+		{
+			name:    "show opt-in section with show all",
+			show:    []string{"locals"},
+			showall: true,
+			wantErr: false,
+		},
+		{
+			name:    "show section with show all",
+			show:    []string{"inputs"},
+			showall: true,
+			wantErr: true,
+		},
+		{
+			name:    "show invalid section",
+			show:    []string{"foo"},
+			showall: false,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			s := defaultSections()
+			s.Show = tt.show
+			s.ShowAll = tt.showall
+
+			if tt.wantErr {
+				assert.NotNil(s.validate())
+			} else {
+				assert.Nil(s.validate())
+			}
+		})
+	}
+}
+
+func TestConfigValidateUsageSection(t *testing.T) {
+	tests := []struct {
+		name      string
+		formatter string
+		wantErr   bool
+	}{
+		{
+			name:      "usage section of markdown",
+			formatter: "markdown table",
+			wantErr:   false,
+		},
+		{
+			name:      "usage section of asciidoc alias",
+			formatter: "adoc document",
+			wantErr:   false,
+		},
+		{
+			name:      "usage section of html",
+			formatter: "html",
+			wantErr:   false,
+		},
+		{
+			name:      "usage section of json",
+			formatter: "json",
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			config := DefaultConfig()
+			config.Formatter = tt.formatter
+			config.Sections.Show = []string{"usage"}
+
+			if tt.wantErr {
+				assert.NotNil(config.validate())
+			} else {
+				assert.Nil(config.validate())
+			}
+		})
+	}
+}
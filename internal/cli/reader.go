@@ -53,10 +53,15 @@ func (c *cfgreader) parse() error {
 		}
 
 		switch flag {
-		case "header-from":
+		case "header-from", "header-stop-at", "footer-from", "provider-link", "resource-link", "strict", "verbose":
 			if err := c.overrideValue(flag, c.config, &c.overrides); err != nil {
 				return err
 			}
+		case "recursive", "recursive-path":
+			mapping := map[string]string{"recursive": "enabled", "recursive-path": "path"}
+			if err := c.overrideValue(mapping[flag], &c.config.Recursive, &c.overrides.Recursive); err != nil {
+				return err
+			}
 		case "show":
 			c.overrideShow()
 		case "hide":
@@ -78,12 +83,17 @@ func (c *cfgreader) parse() error {
 			if !el.FieldByName(field).Bool() {
 				c.config.Sort.ByList = remove(c.config.Sort.ByList, mapping[flag])
 			}
+		case "output-file", "output-marker-begin", "output-marker-end", "output-check", "diff":
+			mapping := map[string]string{"output-file": "file", "output-marker-begin": "marker-begin", "output-marker-end": "marker-end", "output-check": "check", "diff": "diff"}
+			if err := c.overrideValue(mapping[flag], &c.config.Output, &c.overrides.Output); err != nil {
+				return err
+			}
 		case "output-values", "output-values-from":
 			mapping := map[string]string{"output-values": "enabled", "output-values-from": "from"}
 			if err := c.overrideValue(mapping[flag], &c.config.OutputValues, &c.overrides.OutputValues); err != nil {
 				return err
 			}
-		case "color", "escape", "indent", "required", "sensitive":
+		case "color", "default-hcl", "default-source", "escape", "fragment", "indent", "module-source", "module-version", "required", "sensitive":
 			if err := c.overrideValue(flag, &c.config.Settings, &c.overrides.Settings); err != nil {
 				return err
 			}
@@ -113,7 +123,7 @@ func (c *cfgreader) overrideValue(name string, to interface{}, from interface{})
 
 func (c *cfgreader) overrideShow() {
 	for _, item := range c.overrides.Sections.Show {
-		if c.config.Sections.ShowAll {
+		if c.config.Sections.ShowAll && !contains(optinSections, item) {
 			if contains(c.config.Sections.Hide, item) {
 				c.config.Sections.Hide = remove(c.config.Sections.Hide, item)
 				c.config.Sections.Show = remove(c.config.Sections.Show, item)
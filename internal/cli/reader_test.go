@@ -195,6 +195,15 @@ func TestOverrideShow(t *testing.T) {
 			expectedShow: []string{"inputs"},
 			expectedHide: []string{"inputs"},
 		},
+		{
+			name:         "override opt-in section show",
+			show:         []string{},
+			hide:         []string{"inputs"},
+			showall:      true,
+			overrideShow: []string{"locals"},
+			expectedShow: []string{"locals"},
+			expectedHide: []string{"inputs"},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
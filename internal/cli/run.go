@@ -2,14 +2,18 @@ package cli
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
 	"github.com/terraform-docs/terraform-docs/internal/format"
 	"github.com/terraform-docs/terraform-docs/internal/module"
+	"github.com/terraform-docs/terraform-docs/internal/tfconfig"
+	"github.com/terraform-docs/terraform-docs/pkg/print"
 )
 
 // list of flagset items which are explicitly changed from CLI
@@ -37,7 +41,28 @@ func PreRunEFunc(config *Config) func(*cobra.Command, []string) error {
 			return fmt.Errorf("value of '--config' can't be empty")
 		}
 
-		file := filepath.Join(args[0], config.File)
+		paths, err := findPaths(args)
+		if err != nil {
+			return err
+		}
+
+		// multiple modules are going to be processed in batch, each one with
+		// its own config file (if available) which is read in RunEFunc
+		if len(paths) > 1 {
+			if formatter != "root" {
+				config.Formatter = formatter
+			}
+			config.process()
+			if config.Recursive.Enabled {
+				return fmt.Errorf("'--recursive' can't be used with multiple paths")
+			}
+			if config.Parallelism < 1 {
+				return fmt.Errorf("value of '--parallelism' must be greater than 0")
+			}
+			return nil
+		}
+
+		file := filepath.Join(paths[0], config.File)
 		cfgreader := &cfgreader{
 			file:   file,
 			config: config,
@@ -84,28 +109,206 @@ func PreRunEFunc(config *Config) func(*cobra.Command, []string) error {
 // initializes required print.Format instance and executes it.
 func RunEFunc(config *Config) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
-		settings, options := config.extract()
-
-		printer, err := format.Factory(config.Formatter, settings)
+		paths, err := findPaths(args)
 		if err != nil {
 			return err
 		}
 
-		options.Path = args[0]
+		b := &batch{
+			config:    config,
+			formatter: cmd.Annotations["command"],
+			modules:   paths,
+		}
+
+		if config.Recursive.Enabled {
+			b.root = paths[0]
+			b.modules, err = findModules(paths[0], config.Recursive.Path)
+			if err != nil {
+				return err
+			}
+		} else if len(paths) == 1 {
+			return newGenerator().generate(config, paths[0])
+		}
+
+		return b.run()
+	}
+}
 
-		tfmodule, err := module.LoadWithOptions(options)
+// generator loads Terraform modules and renders them with the formatter
+// of provided Config. The formatter (and its print.Settings) is created
+// once per Config, and reused for all the modules which share the same
+// Config. A generator is not safe for concurrent use.
+type generator struct {
+	printers map[*Config]*printer
+}
+
+type printer struct {
+	format   print.Format
+	settings *print.Settings
+	options  *module.Options
+}
+
+func newGenerator() *generator {
+	return &generator{
+		printers: make(map[*Config]*printer),
+	}
+}
+
+// generate loads the module in 'dir', renders it with the formatter
+// of provided Config and writes the generated content.
+func (g *generator) generate(config *Config, dir string) error {
+	p, ok := g.printers[config]
+	if !ok {
+		settings, options := config.extract()
+
+		format, err := format.Factory(config.Formatter, settings)
 		if err != nil {
 			return err
+		}
 
+		p = &printer{
+			format:   format,
+			settings: settings,
+			options:  options,
 		}
+		g.printers[config] = p
+	}
+
+	options := *p.options
+	options.Path = dir
+
+	tfmodule, err := module.LoadWithOptions(&options)
+	if err != nil {
+		return err
+	}
+
+	output, err := p.format.Print(tfmodule, p.settings)
+	if err != nil {
+		return err
+	}
+
+	return writeContent(config, options.Path, output)
+}
 
-		output, err := printer.Print(tfmodule, settings)
+// writeContent writes the generated 'content' to os.Stdout, or to
+// the output file if 'output.file' is set.
+func writeContent(config *Config, dir string, content string) error {
+	var w io.Writer = &stdoutWriter{}
+
+	if config.Output.File != "" {
+		begin, end := config.Output.markers()
+		w = &fileWriter{
+			file:  config.Output.File,
+			dir:   dir,
+			begin: begin,
+			end:   end,
+			check: config.Output.Check,
+			diff:  config.Output.Diff,
+		}
+	}
+
+	_, err := io.WriteString(w, content)
+
+	return err
+}
+
+// findPaths returns the list of paths provided as arguments, in which
+// glob patterns are expanded to all the matching Terraform modules. Hidden
+// directories (e.g. '.terraform') are skipped, as in findModules.
+func findPaths(args []string) ([]string, error) {
+	var paths []string
+	for _, arg := range args {
+		matches, err := filepath.Glob(arg)
+		if err != nil {
+			return nil, err
+		}
+		if len(matches) == 0 {
+			// no glob pattern in 'arg' or nothing matched, let the
+			// module loader report if the path doesn't exist.
+			matches = []string{arg}
+		}
+		for _, match := range matches {
+			if match != arg {
+				if strings.HasPrefix(filepath.Base(match), ".") || !tfconfig.IsModuleDir(match) {
+					continue
+				}
+			}
+			if !contains(paths, match) {
+				paths = append(paths, match)
+			}
+		}
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no module found matching '%s'", strings.Join(args, " "))
+	}
+	return paths, nil
+}
+
+// findModules returns the list of Terraform modules in 'root' and
+// recursively in all the directories under 'root/path'. Hidden
+// directories (e.g. '.terraform') are skipped.
+func findModules(root string, path string) ([]string, error) {
+	var modules []string
+	if tfconfig.IsModuleDir(root) {
+		modules = append(modules, root)
+	}
+	dir := filepath.Join(root, path)
+	info, err := os.Stat(dir)
+	if os.IsNotExist(err) || (err == nil && !info.IsDir()) {
+		return nil, fmt.Errorf("recursive path '%s' not found or is not a directory", dir)
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
+		if !info.IsDir() {
+			return nil
+		}
+		if p != dir && strings.HasPrefix(info.Name(), ".") {
+			return filepath.SkipDir
+		}
+		// root module is already added, e.g. if 'path' resolves to it
+		if tfconfig.IsModuleDir(p) && !contains(modules, p) {
+			modules = append(modules, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return modules, nil
+}
+
+// moduleConfig returns Config of the module in 'dir'. If the module has
+// its own config file, it gets read on top of the Config of root module
+// (and flags passed through CLI take precedence over both of them),
+// otherwise the Config of root module is used as is.
+func moduleConfig(config *Config, dir string, formatter string) (*Config, error) {
+	cfgreader := &cfgreader{
+		file:   filepath.Join(dir, config.File),
+		config: config.copy(),
+	}
 
-		fmt.Println(output)
+	if found, _ := cfgreader.exist(); !found {
+		return config, config.validate()
+	}
 
-		return nil
+	if err := cfgreader.parse(); err != nil {
+		return nil, err
+	}
+
+	if formatter != "root" {
+		cfgreader.config.Formatter = formatter
 	}
+
+	cfgreader.config.process()
+
+	if err := cfgreader.config.validate(); err != nil {
+		return nil, err
+	}
+
+	return cfgreader.config, nil
 }
@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindModules(t *testing.T) {
+	root := filepath.Join("testdata", "recursive")
+	tests := []struct {
+		name     string
+		path     string
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name: "find modules recursively",
+			path: "modules",
+			expected: []string{
+				root,
+				filepath.Join(root, "modules", "bar"),
+				filepath.Join(root, "modules", "bar", "nested"),
+				filepath.Join(root, "modules", "foo"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "find modules recursively in subpath",
+			path: filepath.Join("modules", "bar"),
+			expected: []string{
+				root,
+				filepath.Join(root, "modules", "bar"),
+				filepath.Join(root, "modules", "bar", "nested"),
+			},
+			wantErr: false,
+		},
+		{
+			name: "find modules recursively in root path",
+			path: ".",
+			expected: []string{
+				root,
+				filepath.Join(root, "modules", "bar"),
+				filepath.Join(root, "modules", "bar", "nested"),
+				filepath.Join(root, "modules", "foo"),
+			},
+			wantErr: false,
+		},
+		{
+			name:     "find modules recursively in non-existing path",
+			path:     "noop",
+			expected: nil,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual, err := findModules(root, tt.path)
+
+			if tt.wantErr {
+				assert.NotNil(err)
+			} else {
+				assert.Nil(err)
+			}
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestModuleConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		dir       string
+		formatter string
+		expected  string
+		sections  []string
+		same      bool
+	}{
+		{
+			name:      "module without config file",
+			dir:       filepath.Join("testdata", "recursive", "modules", "foo"),
+			formatter: "root",
+			expected:  "markdown table",
+			sections:  []string{},
+			same:      true,
+		},
+		{
+			name:      "module with config file",
+			dir:       filepath.Join("testdata", "recursive", "modules", "bar"),
+			formatter: "root",
+			expected:  "markdown document",
+			sections:  []string{"providers"},
+			same:      false,
+		},
+		{
+			name:      "module with config file and formatter subcommand",
+			dir:       filepath.Join("testdata", "recursive", "modules", "bar"),
+			formatter: "json",
+			expected:  "json",
+			sections:  []string{"providers"},
+			same:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			config := DefaultConfig()
+			config.File = ".terraform-docs.yml"
+			config.Formatter = "markdown table"
+			config.Output.File = "README.md"
+
+			actual, err := moduleConfig(config, tt.dir, tt.formatter)
+
+			assert.Nil(err)
+			assert.Equal(tt.same, actual == config)
+			assert.Equal(tt.expected, actual.Formatter)
+			assert.Equal(tt.sections, actual.Sections.Hide)
+			assert.Equal("README.md", actual.Output.File)
+
+			// make sure root config is untouched
+			assert.Equal("markdown table", config.Formatter)
+			assert.Equal([]string{}, config.Sections.Hide)
+		})
+	}
+}
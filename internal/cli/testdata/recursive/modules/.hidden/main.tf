@@ -0,0 +1,4 @@
+variable "foo" {
+  description = "Foo of the resource."
+  type        = string
+}
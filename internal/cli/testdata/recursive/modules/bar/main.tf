@@ -0,0 +1,4 @@
+output "bar" {
+  description = "Bar of the resource."
+  value       = "bar"
+}
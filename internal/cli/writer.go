@@ -0,0 +1,160 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// stdoutWriter writes content to os.Stdout.
+type stdoutWriter struct{}
+
+func (sw *stdoutWriter) Write(p []byte) (int, error) {
+	return os.Stdout.Write([]byte(string(p) + "\n"))
+}
+
+// fileWriter writes content to a file. Only the content between 'begin'
+// and 'end' markers gets replaced, everything else in the file is kept
+// intact. If the file doesn't exist it will be created and if markers
+// can't be found the block of content will be appended to the end of it.
+//
+// If 'check' is set the file is not modified, instead an error is returned
+// when its content differs from what would have been written to it. And if
+// 'diff' is set the file is not modified either, instead a unified diff of
+// the changes which would have been written to it is printed.
+type fileWriter struct {
+	file string
+	dir  string
+
+	begin string
+	end   string
+
+	check bool
+	diff  bool
+}
+
+func (fw *fileWriter) Write(p []byte) (int, error) {
+	filename := fw.file
+	if !filepath.IsAbs(filename) {
+		filename = filepath.Join(fw.dir, filename)
+	}
+
+	current, err := fw.read(filename)
+	if err != nil {
+		return 0, err
+	}
+
+	content, err := fw.inject(current, string(p))
+	if err != nil {
+		return 0, fmt.Errorf("%s: %v", filename, err)
+	}
+
+	if fw.diff {
+		diff, err := fw.unifiedDiff(filename, current, content)
+		if err != nil {
+			return 0, err
+		}
+		fmt.Print(diff)
+	}
+
+	if fw.check {
+		if content != current {
+			return 0, fmt.Errorf("%s is out of date, run terraform-docs to regenerate it", filename)
+		}
+		fmt.Printf("%s is up to date\n", filename)
+		return len(p), nil
+	}
+
+	if fw.diff {
+		return len(p), nil
+	}
+
+	if err := ioutil.WriteFile(filename, []byte(content), 0644); err != nil {
+		return 0, err
+	}
+
+	fmt.Printf("%s updated successfully\n", filename)
+
+	return len(p), nil
+}
+
+// read returns the content of 'filename', or empty if the file doesn't exist.
+func (fw *fileWriter) read(filename string) (string, error) {
+	info, err := os.Stat(filename)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if info.IsDir() {
+		return "", fmt.Errorf("%s is a directory", filename)
+	}
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+// unifiedDiff returns unified diff of 'current' and 'generated' content
+// of 'filename', or empty if they are identical.
+func (fw *fileWriter) unifiedDiff(filename string, current string, generated string) (string, error) {
+	lines := func(s string) []string {
+		l := strings.SplitAfter(s, "\n")
+		if l[len(l)-1] == "" {
+			l = l[:len(l)-1]
+		}
+		return l
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        lines(current),
+		B:        lines(generated),
+		FromFile: filename,
+		ToFile:   filename,
+		Context:  3,
+	})
+}
+
+// inject replaces content between 'begin' and 'end' markers in 'current'
+// with 'generated' and returns the result.
+func (fw *fileWriter) inject(current string, generated string) (string, error) {
+	block := fmt.Sprintf("%s\n%s\n%s", fw.begin, strings.TrimSuffix(generated, "\n"), fw.end)
+
+	if current == "" {
+		return block + "\n", nil
+	}
+
+	before := strings.Index(current, fw.begin)
+	after := -1
+
+	// end marker is looked up after begin marker, as it may also appear
+	// earlier in the file (e.g. in an example of using the markers)
+	if before >= 0 {
+		if i := strings.Index(current[before+len(fw.begin):], fw.end); i >= 0 {
+			after = before + len(fw.begin) + i
+		}
+	}
+
+	if before < 0 && !strings.Contains(current, fw.end) {
+		if !strings.HasSuffix(current, "\n") {
+			current += "\n"
+		}
+		return current + "\n" + block + "\n", nil
+	}
+	if before < 0 {
+		return "", fmt.Errorf("begin marker '%s' is missing", fw.begin)
+	}
+	if after < 0 && strings.Contains(current, fw.end) {
+		return "", fmt.Errorf("end marker '%s' is placed before begin marker '%s'", fw.end, fw.begin)
+	}
+	if after < 0 {
+		return "", fmt.Errorf("end marker '%s' is missing", fw.end)
+	}
+
+	return current[:before] + block + current[after+len(fw.end):], nil
+}
@@ -0,0 +1,325 @@
+package cli
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileWriterInject(t *testing.T) {
+	tests := []struct {
+		name      string
+		current   string
+		generated string
+		expected  string
+		wantErr   bool
+		errMsg    string
+	}{
+		{
+			name:      "inject into empty file",
+			current:   "",
+			generated: "foo\n",
+			expected:  "<!-- BEGIN_TF_DOCS -->\nfoo\n<!-- END_TF_DOCS -->\n",
+			wantErr:   false,
+			errMsg:    "",
+		},
+		{
+			name:      "inject between markers",
+			current:   "# Title\n\n<!-- BEGIN_TF_DOCS -->\nold\ncontent\n<!-- END_TF_DOCS -->\n\nfooter\n",
+			generated: "foo\nbar\n",
+			expected:  "# Title\n\n<!-- BEGIN_TF_DOCS -->\nfoo\nbar\n<!-- END_TF_DOCS -->\n\nfooter\n",
+			wantErr:   false,
+			errMsg:    "",
+		},
+		{
+			name:      "inject between empty markers",
+			current:   "# Title\n<!-- BEGIN_TF_DOCS --><!-- END_TF_DOCS -->\n",
+			generated: "foo",
+			expected:  "# Title\n<!-- BEGIN_TF_DOCS -->\nfoo\n<!-- END_TF_DOCS -->\n",
+			wantErr:   false,
+			errMsg:    "",
+		},
+		{
+			name:      "append when markers are missing",
+			current:   "# Title",
+			generated: "foo\n",
+			expected:  "# Title\n\n<!-- BEGIN_TF_DOCS -->\nfoo\n<!-- END_TF_DOCS -->\n",
+			wantErr:   false,
+			errMsg:    "",
+		},
+		{
+			name:      "inject between markers with end marker placed earlier",
+			current:   "Usage: `<!-- END_TF_DOCS -->`\n\n<!-- BEGIN_TF_DOCS -->\nold\n<!-- END_TF_DOCS -->\n",
+			generated: "foo\n",
+			expected:  "Usage: `<!-- END_TF_DOCS -->`\n\n<!-- BEGIN_TF_DOCS -->\nfoo\n<!-- END_TF_DOCS -->\n",
+			wantErr:   false,
+			errMsg:    "",
+		},
+		{
+			name:      "begin marker is missing",
+			current:   "# Title\nold\n<!-- END_TF_DOCS -->\n",
+			generated: "foo\n",
+			expected:  "",
+			wantErr:   true,
+			errMsg:    "begin marker '<!-- BEGIN_TF_DOCS -->' is missing",
+		},
+		{
+			name:      "end marker is missing",
+			current:   "# Title\n<!-- BEGIN_TF_DOCS -->\nold\n",
+			generated: "foo\n",
+			expected:  "",
+			wantErr:   true,
+			errMsg:    "end marker '<!-- END_TF_DOCS -->' is missing",
+		},
+		{
+			name:      "markers are misplaced",
+			current:   "<!-- END_TF_DOCS -->\nold\n<!-- BEGIN_TF_DOCS -->\n",
+			generated: "foo\n",
+			expected:  "",
+			wantErr:   true,
+			errMsg:    "end marker '<!-- END_TF_DOCS -->' is placed before begin marker '<!-- BEGIN_TF_DOCS -->'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			fw := &fileWriter{
+				begin: "<!-- BEGIN_TF_DOCS -->",
+				end:   "<!-- END_TF_DOCS -->",
+			}
+
+			actual, err := fw.inject(tt.current, tt.generated)
+
+			if tt.wantErr {
+				assert.NotNil(err)
+				assert.Equal(tt.errMsg, err.Error())
+			} else {
+				assert.Nil(err)
+				assert.Equal(tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFileWriterWrite(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "terraform-docs")
+	assert.Nil(err)
+	defer os.RemoveAll(dir) //nolint:errcheck
+
+	fw := &fileWriter{
+		file:  "README.adoc",
+		dir:   dir,
+		begin: "// BEGIN_TF_DOCS",
+		end:   "// END_TF_DOCS",
+	}
+
+	n, err := fw.Write([]byte("foo\n"))
+	assert.Nil(err)
+	assert.Equal(4, n)
+
+	actual, err := ioutil.ReadFile(filepath.Join(dir, "README.adoc"))
+	assert.Nil(err)
+	assert.Equal("// BEGIN_TF_DOCS\nfoo\n// END_TF_DOCS\n", string(actual))
+
+	_, err = fw.Write([]byte("bar\n"))
+	assert.Nil(err)
+
+	actual, err = ioutil.ReadFile(filepath.Join(dir, "README.adoc"))
+	assert.Nil(err)
+	assert.Equal("// BEGIN_TF_DOCS\nbar\n// END_TF_DOCS\n", string(actual))
+}
+
+func TestFileWriterWriteAbsolutePath(t *testing.T) {
+	assert := assert.New(t)
+
+	dir, err := ioutil.TempDir("", "terraform-docs")
+	assert.Nil(err)
+	defer os.RemoveAll(dir) //nolint:errcheck
+
+	fw := &fileWriter{
+		file:  filepath.Join(dir, "README.md"),
+		dir:   filepath.Join(dir, "module"),
+		begin: "<!-- BEGIN_TF_DOCS -->",
+		end:   "<!-- END_TF_DOCS -->",
+	}
+
+	_, err = fw.Write([]byte("foo\n"))
+	assert.Nil(err)
+
+	actual, err := ioutil.ReadFile(filepath.Join(dir, "README.md"))
+	assert.Nil(err)
+	assert.Equal("<!-- BEGIN_TF_DOCS -->\nfoo\n<!-- END_TF_DOCS -->\n", string(actual))
+}
+
+func TestOutputMarkers(t *testing.T) {
+	tests := []struct {
+		name   string
+		file   string
+		custom [2]string
+		begin  string
+		end    string
+	}{
+		{
+			name:  "markers of markdown file",
+			file:  "README.md",
+			begin: "<!-- BEGIN_TF_DOCS -->",
+			end:   "<!-- END_TF_DOCS -->",
+		},
+		{
+			name:  "markers of asciidoc file",
+			file:  "README.adoc",
+			begin: "// BEGIN_TF_DOCS",
+			end:   "// END_TF_DOCS",
+		},
+		{
+			name:  "markers of unknown file",
+			file:  "README",
+			begin: "<!-- BEGIN_TF_DOCS -->",
+			end:   "<!-- END_TF_DOCS -->",
+		},
+		{
+			name:   "custom markers",
+			file:   "README.adoc",
+			custom: [2]string{"// BEGIN", "// END"},
+			begin:  "// BEGIN",
+			end:    "// END",
+		},
+		{
+			name:   "custom begin marker",
+			file:   "README.md",
+			custom: [2]string{"<!-- BEGIN -->", ""},
+			begin:  "<!-- BEGIN -->",
+			end:    "<!-- END_TF_DOCS -->",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			o := output{File: tt.file, MarkerBegin: tt.custom[0], MarkerEnd: tt.custom[1]}
+
+			begin, end := o.markers()
+
+			assert.Equal(tt.begin, begin)
+			assert.Equal(tt.end, end)
+		})
+	}
+}
+
+func TestFileWriterCheck(t *testing.T) {
+	tests := []struct {
+		name    string
+		current string
+		create  bool
+		wantErr bool
+	}{
+		{
+			name:    "file is up to date",
+			current: "# Title\n\n<!-- BEGIN_TF_DOCS -->\nfoo\n<!-- END_TF_DOCS -->\n",
+			create:  true,
+			wantErr: false,
+		},
+		{
+			name:    "file is out of date",
+			current: "# Title\n\n<!-- BEGIN_TF_DOCS -->\nbar\n<!-- END_TF_DOCS -->\n",
+			create:  true,
+			wantErr: true,
+		},
+		{
+			name:    "file without markers",
+			current: "# Title\n",
+			create:  true,
+			wantErr: true,
+		},
+		{
+			name:    "file does not exist",
+			current: "",
+			create:  false,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			dir, err := ioutil.TempDir("", "terraform-docs")
+			assert.Nil(err)
+			defer os.RemoveAll(dir) //nolint:errcheck
+
+			filename := filepath.Join(dir, "README.md")
+			if tt.create {
+				err = ioutil.WriteFile(filename, []byte(tt.current), 0644)
+				assert.Nil(err)
+			}
+
+			fw := &fileWriter{
+				file:  "README.md",
+				dir:   dir,
+				begin: "<!-- BEGIN_TF_DOCS -->",
+				end:   "<!-- END_TF_DOCS -->",
+				check: true,
+			}
+
+			_, err = fw.Write([]byte("foo\n"))
+
+			if tt.wantErr {
+				assert.NotNil(err)
+				assert.Equal(filename+" is out of date, run terraform-docs to regenerate it", err.Error())
+			} else {
+				assert.Nil(err)
+			}
+
+			// make sure file is untouched
+			_, err = os.Stat(filename)
+			assert.Equal(tt.create, err == nil)
+			if tt.create {
+				actual, err := ioutil.ReadFile(filename)
+				assert.Nil(err)
+				assert.Equal(tt.current, string(actual))
+			}
+		})
+	}
+}
+
+func TestFileWriterUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		name      string
+		current   string
+		generated string
+		expected  string
+	}{
+		{
+			name:      "identical content",
+			current:   "foo\nbar\n",
+			generated: "foo\nbar\n",
+			expected:  "",
+		},
+		{
+			name:      "changed content",
+			current:   "# Title\n<!-- BEGIN_TF_DOCS -->\nfoo\n<!-- END_TF_DOCS -->\n",
+			generated: "# Title\n<!-- BEGIN_TF_DOCS -->\nbar\n<!-- END_TF_DOCS -->\n",
+			expected:  "--- README.md\n+++ README.md\n@@ -1,4 +1,4 @@\n # Title\n <!-- BEGIN_TF_DOCS -->\n-foo\n+bar\n <!-- END_TF_DOCS -->\n",
+		},
+		{
+			name:      "new content",
+			current:   "",
+			generated: "foo\n",
+			expected:  "--- README.md\n+++ README.md\n@@ -0,0 +1 @@\n+foo\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			fw := &fileWriter{}
+
+			actual, err := fw.unifiedDiff("README.md", tt.current, tt.generated)
+
+			assert.Nil(err)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
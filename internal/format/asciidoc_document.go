@@ -1,6 +1,7 @@
 package format
 
 import (
+	"fmt"
 	"text/template"
 
 	"github.com/terraform-docs/terraform-docs/pkg/print"
@@ -18,6 +19,15 @@ const (
 	{{ end -}}
 	`
 
+	asciidocDocumentFooterTpl = `
+	{{- if .Settings.ShowFooter -}}
+		{{- with .Module.Footer -}}
+			{{ sanitizeHeader . }}
+			{{ printf "\n" }}
+		{{- end -}}
+	{{ end -}}
+	`
+
 	asciidocDocumentRequirementsTpl = `
 	{{- if .Settings.ShowRequirements -}}
 		{{ indent 0 "=" }} Requirements
@@ -27,7 +37,8 @@ const (
 			The following requirements are needed by this module:
 			{{- range .Module.Requirements }}
 				{{ $version := ternary (tostring .Version) (printf " (%s)" .Version) "" }}
-				- {{ name .Name }}{{ $version }}
+				{{ $source := ternary (tostring .URL) (printf "%s[%s]" .URL .Source) (tostring .Source) }}
+				- {{ name .Name }}{{ $version }}{{ ternary $source (printf " from %s" $source) "" }}
 			{{- end }}
 		{{ end }}
 	{{ end -}}
@@ -42,12 +53,60 @@ const (
 			The following providers are used by this module:
 			{{- range .Module.Providers }}
 				{{ $version := ternary (tostring .Version) (printf " (%s)" .Version) "" }}
-				- {{ name .FullName }}{{ $version }}
+				{{ $source := ternary (tostring .URL) (printf "%s[%s]" .URL .Source) (tostring .Source) }}
+				- {{ name .FullName }}{{ $version }}{{ ternary $source (printf " from %s" $source) "" }} ({{ .Kind }})
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	asciidocDocumentModulesTpl = `
+	{{- if .Settings.ShowModules -}}
+		{{ indent 0 "=" }} Modules
+		{{ if not .Module.Modules }}
+			No module.
+		{{ else }}
+			The following modules are called by this module:
+			{{- range .Module.Modules }}
+
+				{{ indent 1 "=" }} {{ .Name }}
+
+				Source: {{ .Source | sanitizeDoc }}
+
+				Version: {{ tostring .Version | default "n/a" }}
+			{{ end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	asciidocDocumentResourcesTpl = `
+	{{- if .Settings.ShowResources -}}
+		{{ indent 0 "=" }} Resources
+		{{ if not .Module.Resources }}
+			No resource.
+		{{ else }}
+			The following resources are used by this module:
+			{{- range .Module.Resources }}
+				{{ $address := name .Address }}
+				- {{ ternary (tostring .URL) (printf "%s[%s]" .URL $address) $address }} ({{ ternary .IsData "data source" "resource" }}, provider: {{ name .Provider }}{{ with .Position.String }}, position: {{ name . }}{{ end }})
 			{{- end }}
 		{{ end }}
 	{{ end -}}
 	`
 
+	asciidocDocumentTagsTpl = `
+	{{ with .Get "since" }}
+		Since: {{ sanitizeDoc . }}
+	{{- end }}
+
+	{{ with .Get "group" }}
+		Group: {{ sanitizeDoc . }}
+	{{- end }}
+
+	{{ with .Get "example" }}
+		Example: {{ type . }}
+	{{- end }}
+	`
 	asciidocDocumentInputsTpl = `
 	{{- if .Settings.ShowInputs -}}
 		{{- if .Settings.ShowRequired -}}
@@ -87,13 +146,46 @@ const (
 	{{ printf "\n" }}
 	{{ indent 1 "=" }} {{ name .Name }}
 
+	{{ if .Tags.Has "deprecated" }}
+		*Deprecated*{{ with .Tags.Get "deprecated" }}: {{ sanitizeDoc . }}{{ end }}
+	{{- end }}
+
 	Description: {{ tostring .Description | sanitizeDoc }}
 
 	Type: {{ tostring .Type | type }}
 
+	{{ if .HasAttributes }}
+		Attributes:
+
+		[cols="a,a,a,a,a",options="header,autowidth"]
+		|===
+		|Name |Description |Type |Default |Required
+		{{- range attributes .Structure }}
+			|{{ .Name }}
+			|{{ tostring .Description | default "n/a" | sanitizeAsciidocTbl }}
+			|{{ attributeType .Type }}
+			|{{ attributeDefault . | sanitizeAsciidocTbl }}
+			|{{ ternary .Optional "no" "yes" }}
+		{{ end }}
+		|===
+	{{- end }}
+
 	{{ if or .HasDefault (not isRequired) }}
-		Default: {{ default "n/a" .GetValue | value }}
+		Default: {{ defaultValue . }}
+	{{- end }}
+
+	{{ if and .Sensitive showSensitivity }}
+		Sensitive: yes
+	{{- end }}
+
+	{{ if .HasValidations }}
+		Validation:
+		{{ range .Validations }}
+			- {{ condition .Condition }}: {{ tostring .ErrorMessage | sanitizeDoc }}
+		{{- end }}
 	{{- end }}
+
+	{{ template "tags" .Tags }}
 	`
 
 	asciidocDocumentOutputsTpl = `
@@ -107,6 +199,10 @@ const (
 
 				{{ indent 1 "=" }} {{ name .Name }}
 
+				{{ if .Tags.Has "deprecated" }}
+					*Deprecated*{{ with .Tags.Get "deprecated" }}: {{ sanitizeDoc . }}{{ end }}
+				{{- end }}
+
 				Description: {{ tostring .Description | sanitizeDoc }}
 
 				{{ if $.Settings.OutputValues }}
@@ -116,18 +212,55 @@ const (
 					{{ if $.Settings.ShowSensitivity -}}
 						Sensitive: {{ ternary (.Sensitive) "yes" "no" }}
 					{{- end }}
+				{{ else if and $.Settings.ShowSensitivity .Sensitive }}
+					Sensitive: yes
 				{{ end }}
+
+				{{ template "tags" .Tags }}
 			{{ end }}
 		{{ end }}
 	{{ end -}}
 	`
 
+	asciidocDocumentLocalsTpl = `
+	{{- if .Settings.ShowLocals -}}
+		{{ indent 0 "=" }} Locals
+		{{ if not .Module.Locals }}
+			No local.
+		{{ else }}
+			The following local values are declared:
+			{{- range .Module.Locals }}
+
+				{{ indent 1 "=" }} {{ name .Name }}
+
+				Description: {{ tostring .Description | sanitizeDoc }}
+
+				Value: {{ .Expression | type }}
+			{{ end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	asciidocDocumentUsageTpl = `
+	{{- if .Settings.ShowUsage -}}
+		{{ indent 0 "=" }} Usage
+
+		{{ usage .Module }}
+		{{ printf "\n" }}
+	{{ end -}}
+	`
+
 	asciidocDocumentTpl = `
 	{{- template "header" . -}}
+	{{- template "usage" . -}}
 	{{- template "requirements" . -}}
 	{{- template "providers" . -}}
+	{{- template "modules" . -}}
+	{{- template "resources" . -}}
 	{{- template "inputs" . -}}
 	{{- template "outputs" . -}}
+	{{- template "locals" . -}}
+	{{- template "footer" . -}}
 	`
 )
 
@@ -144,25 +277,46 @@ func NewAsciidocDocument(settings *print.Settings) *AsciidocDocument {
 	}, &tmpl.Item{
 		Name: "header",
 		Text: asciidocDocumentHeaderTpl,
+	}, &tmpl.Item{
+		Name: "footer",
+		Text: asciidocDocumentFooterTpl,
 	}, &tmpl.Item{
 		Name: "requirements",
 		Text: asciidocDocumentRequirementsTpl,
 	}, &tmpl.Item{
 		Name: "providers",
 		Text: asciidocDocumentProvidersTpl,
+	}, &tmpl.Item{
+		Name: "modules",
+		Text: asciidocDocumentModulesTpl,
+	}, &tmpl.Item{
+		Name: "resources",
+		Text: asciidocDocumentResourcesTpl,
 	}, &tmpl.Item{
 		Name: "inputs",
 		Text: asciidocDocumentInputsTpl,
 	}, &tmpl.Item{
 		Name: "input",
 		Text: asciidocDocumentInputTpl,
+	}, &tmpl.Item{
+		Name: "tags",
+		Text: asciidocDocumentTagsTpl,
 	}, &tmpl.Item{
 		Name: "outputs",
 		Text: asciidocDocumentOutputsTpl,
+	}, &tmpl.Item{
+		Name: "locals",
+		Text: asciidocDocumentLocalsTpl,
+	}, &tmpl.Item{
+		Name: "usage",
+		Text: asciidocDocumentUsageTpl,
 	})
 	settings.EscapeCharacters = false
 	tt.Settings(settings)
 	tt.CustomFunc(template.FuncMap{
+		"usage": func(m *tfconf.Module) string {
+			return fmt.Sprintf("[source,hcl]\n----\n%s\n----", printUsage(m, settings, true, true))
+		},
 		"type": func(t string) string {
 			result, extraline := printFencedAsciidocCodeBlock(t, "hcl")
 			if !extraline {
@@ -180,9 +334,36 @@ func NewAsciidocDocument(settings *print.Settings) *AsciidocDocument {
 			}
 			return result
 		},
+		"defaultValue": func(i *tfconf.Input) string {
+			value, language := inputDefault(i, settings)
+			if value == "" {
+				return "n/a"
+			}
+			result, extraline := printFencedAsciidocCodeBlock(value, language)
+			if !extraline {
+				result += "\n"
+			}
+			return result
+		},
+		"attributes": func(t *tfconf.Type) []*tfconf.TypeAttribute {
+			return flattenTypeAttributes(t)
+		},
+		"attributeType": func(t *tfconf.Type) string {
+			result, _ := printFencedCodeBlock(t.String(), "")
+			return result
+		},
+		"attributeDefault": func(a *tfconf.TypeAttribute) string {
+			return printAttributeDefault(a)
+		},
+		"condition": func(c string) string {
+			return printCondition(c)
+		},
 		"isRequired": func() bool {
 			return settings.ShowRequired
 		},
+		"showSensitivity": func() bool {
+			return settings.ShowSensitivity
+		},
 	})
 	return &AsciidocDocument{
 		template: tt,
@@ -1,6 +1,7 @@
 package format
 
 import (
+	"fmt"
 	"text/template"
 
 	"github.com/terraform-docs/terraform-docs/pkg/print"
@@ -18,17 +19,27 @@ const (
 	{{ end -}}
 	`
 
+	asciidocTableFooterTpl = `
+	{{- if .Settings.ShowFooter -}}
+		{{- with .Module.Footer -}}
+			{{ sanitizeHeader . }}
+			{{ printf "\n" }}
+		{{- end -}}
+	{{ end -}}
+	`
+
 	asciidocTableRequirementsTpl = `
 	{{- if .Settings.ShowRequirements -}}
 		{{ indent 0 "=" }} Requirements
 		{{ if not .Module.Requirements }}
 			No requirements.
 		{{ else }}
-			[cols="a,a",options="header,autowidth"]
+			[cols="a,a,a",options="header,autowidth"]
 			|===
-			|Name |Version
+			|Name |Source |Version
 			{{- range .Module.Requirements }}
-				|{{ .Name }} |{{ tostring .Version | default "n/a" }}
+				{{- $source := ternary (tostring .URL) (printf "%s[%s]" .URL .Source) (tostring .Source) }}
+				|{{ .Name }} |{{ default "n/a" $source }} |{{ tostring .Version | default "n/a" }}
 			{{- end }}
 			|===
 		{{ end }}
@@ -41,11 +52,46 @@ const (
 		{{ if not .Module.Providers }}
 			No provider.
 		{{ else }}
-			[cols="a,a",options="header,autowidth"]
+			[cols="a,a,a,a",options="header,autowidth"]
 			|===
-			|Name |Version
+			|Name |Source |Version |Kind
 			{{- range .Module.Providers }}
-				|{{ .FullName }} |{{ tostring .Version | default "n/a" }}
+				{{- $source := ternary (tostring .URL) (printf "%s[%s]" .URL .Source) (tostring .Source) }}
+				|{{ .FullName }} |{{ default "n/a" $source }} |{{ tostring .Version | default "n/a" }} |{{ .Kind }}
+			{{- end }}
+			|===
+		{{ end }}
+	{{ end -}}
+	`
+
+	asciidocTableModulesTpl = `
+	{{- if .Settings.ShowModules -}}
+		{{ indent 0 "=" }} Modules
+		{{ if not .Module.Modules }}
+			No module.
+		{{ else }}
+			[cols="a,a,a",options="header,autowidth"]
+			|===
+			|Name |Source |Version
+			{{- range .Module.Modules }}
+				|{{ .Name }} |{{ .Source | sanitizeAsciidocTbl }} |{{ tostring .Version | default "n/a" }}
+			{{- end }}
+			|===
+		{{ end }}
+	{{ end -}}
+	`
+
+	asciidocTableResourcesTpl = `
+	{{- if .Settings.ShowResources -}}
+		{{ indent 0 "=" }} Resources
+		{{ if not .Module.Resources }}
+			No resource.
+		{{ else }}
+			[cols="a,a,a,a",options="header,autowidth"]
+			|===
+			|Name |Type |Provider |Position
+			{{- range .Module.Resources }}
+				|{{ ternary (tostring .URL) (printf "%s[%s]" .URL .Address) .Address }} |{{ .Type }} |{{ .Provider }} |{{ .Position.String }}
 			{{- end }}
 			|===
 		{{ end }}
@@ -58,15 +104,18 @@ const (
 		{{ if not .Module.Inputs }}
 			No input.
 		{{ else }}
-			[cols="a,a,a,a{{ if .Settings.ShowRequired }},a{{ end }}",options="header,autowidth"]
+			{{- $sensitivity := and .Settings.ShowSensitivity .Module.HasSensitiveInputs }}
+			[cols="a,a,a,a{{ if .Settings.ShowRequired }},a{{ end }}{{ if $sensitivity }},a{{ end }}",options="header,autowidth"]
 			|===
-			|Name |Description |Type |Default{{ if .Settings.ShowRequired }} |Required{{ end }}
+			|Name |Description |Type |Default{{ if .Settings.ShowRequired }} |Required{{ end }}{{ if $sensitivity }} |Sensitive{{ end }}
 			{{- range .Module.Inputs }}
 				|{{ .Name }}
-				|{{ tostring .Description | sanitizeAsciidocTbl }}
+				|{{ if .Tags.Has "deprecated" }}*Deprecated*{{ with .Tags.Get "deprecated" }}: {{ sanitizeAsciidocTbl . }}{{ end }}{{ with tostring .Description }} +
+				{{ sanitizeAsciidocTbl . }}{{ end }}{{ else }}{{ tostring .Description | sanitizeAsciidocTbl }}{{ end }}
 				|{{ tostring .Type | type | sanitizeAsciidocTbl }}
-				|{{ value .GetValue | sanitizeAsciidocTbl }}
+				|{{ value (defaultValue .) | sanitizeAsciidocTbl }}
 				{{ if $.Settings.ShowRequired }}|{{ ternary .Required "yes" "no" }}{{ end }}
+				{{- if $sensitivity }}{{ if $.Settings.ShowRequired }} {{ end }}|{{ ternary .Sensitive "yes" "no" }}{{ end }}
 			{{ end }}
 			|===
 		{{ end }}
@@ -79,17 +128,19 @@ const (
 		{{ if not .Module.Outputs }}
 			No output.
 		{{ else }}
-			[cols="a,a{{ if .Settings.OutputValues }},a{{ if $.Settings.ShowSensitivity }},a{{ end }}{{ end }}",options="header,autowidth"]
+			{{- $sensitivity := and .Settings.ShowSensitivity (or .Settings.OutputValues .Module.HasSensitiveOutputs) }}
+			[cols="a,a{{ if .Settings.OutputValues }},a{{ end }}{{ if $sensitivity }},a{{ end }}",options="header,autowidth"]
 			|===
-			|Name |Description{{ if .Settings.OutputValues }} |Value{{ if $.Settings.ShowSensitivity }} |Sensitive{{ end }}{{ end }}
+			|Name |Description{{ if .Settings.OutputValues }} |Value{{ end }}{{ if $sensitivity }} |Sensitive{{ end }}
 			{{- range .Module.Outputs }}
-				|{{ .Name }} |{{ tostring .Description | sanitizeAsciidocTbl }}
+				|{{ .Name }} |{{ if .Tags.Has "deprecated" }}*Deprecated*{{ with .Tags.Get "deprecated" }}: {{ sanitizeAsciidocTbl . }}{{ end }}{{ with tostring .Description }} +
+				{{ sanitizeAsciidocTbl . }}{{ end }}{{ else }}{{ tostring .Description | sanitizeAsciidocTbl }}{{ end }}
 				{{- if $.Settings.OutputValues -}}
 					{{- $sensitive := ternary .Sensitive "<sensitive>" .GetValue -}}
 					{{ printf " " }}|{{ value $sensitive }}
-					{{- if $.Settings.ShowSensitivity -}}
-						{{ printf " " }}|{{ ternary .Sensitive "yes" "no" }}
-					{{- end -}}
+				{{- end -}}
+				{{- if $sensitivity -}}
+					{{ printf " " }}|{{ ternary .Sensitive "yes" "no" }}
 				{{- end -}}
 			{{- end }}
 			|===
@@ -97,12 +148,43 @@ const (
 	{{ end -}}
 	`
 
+	asciidocTableLocalsTpl = `
+	{{- if .Settings.ShowLocals -}}
+		{{ indent 0 "=" }} Locals
+		{{ if not .Module.Locals }}
+			No local.
+		{{ else }}
+			[cols="a,a,a",options="header,autowidth"]
+			|===
+			|Name |Description |Value
+			{{- range .Module.Locals }}
+				|{{ .Name }} |{{ tostring .Description | sanitizeAsciidocTbl }} |{{ .Expression | type | sanitizeAsciidocTbl }}
+			{{- end }}
+			|===
+		{{ end }}
+	{{ end -}}
+	`
+
+	asciidocTableUsageTpl = `
+	{{- if .Settings.ShowUsage -}}
+		{{ indent 0 "=" }} Usage
+
+		{{ usage .Module }}
+		{{ printf "\n" }}
+	{{ end -}}
+	`
+
 	asciidocTableTpl = `
 	{{- template "header" . -}}
+	{{- template "usage" . -}}
 	{{- template "requirements" . -}}
 	{{- template "providers" . -}}
+	{{- template "modules" . -}}
+	{{- template "resources" . -}}
 	{{- template "inputs" . -}}
 	{{- template "outputs" . -}}
+	{{- template "locals" . -}}
+	{{- template "footer" . -}}
 	`
 )
 
@@ -119,22 +201,40 @@ func NewAsciidocTable(settings *print.Settings) *AsciidocTable {
 	}, &tmpl.Item{
 		Name: "header",
 		Text: asciidocTableHeaderTpl,
+	}, &tmpl.Item{
+		Name: "footer",
+		Text: asciidocTableFooterTpl,
 	}, &tmpl.Item{
 		Name: "requirements",
 		Text: asciidocTableRequirementsTpl,
 	}, &tmpl.Item{
 		Name: "providers",
 		Text: asciidocTableProvidersTpl,
+	}, &tmpl.Item{
+		Name: "modules",
+		Text: asciidocTableModulesTpl,
+	}, &tmpl.Item{
+		Name: "resources",
+		Text: asciidocTableResourcesTpl,
 	}, &tmpl.Item{
 		Name: "inputs",
 		Text: asciidocTableInputsTpl,
 	}, &tmpl.Item{
 		Name: "outputs",
 		Text: asciidocTableOutputsTpl,
+	}, &tmpl.Item{
+		Name: "locals",
+		Text: asciidocTableLocalsTpl,
+	}, &tmpl.Item{
+		Name: "usage",
+		Text: asciidocTableUsageTpl,
 	})
 	settings.EscapeCharacters = false
 	tt.Settings(settings)
 	tt.CustomFunc(template.FuncMap{
+		"usage": func(m *tfconf.Module) string {
+			return fmt.Sprintf("[source,hcl]\n----\n%s\n----", printUsage(m, settings, true, true))
+		},
 		"type": func(t string) string {
 			inputType, _ := printFencedCodeBlock(t, "")
 			return inputType
@@ -146,6 +246,10 @@ func NewAsciidocTable(settings *print.Settings) *AsciidocTable {
 			}
 			return result
 		},
+		"defaultValue": func(i *tfconf.Input) string {
+			value, _ := inputDefault(i, settings)
+			return value
+		},
 	})
 	return &AsciidocTable{
 		template: tt,
@@ -18,8 +18,12 @@ func Factory(name string, settings *print.Settings) (print.Format, error) {
 		return NewAsciidocDocument(settings), nil
 	case "asciidoc table", "asciidoc tbl", "adoc table", "adoc tbl":
 		return NewAsciidocTable(settings), nil
+	case "html":
+		return NewHTML(settings), nil
 	case "json":
 		return NewJSON(settings), nil
+	case "json-schema":
+		return NewJSONSchema(settings), nil
 	case "markdown", "md":
 		return NewTable(settings), nil
 	case "markdown document", "markdown doc", "md document", "md doc":
@@ -28,12 +32,22 @@ func Factory(name string, settings *print.Settings) (print.Format, error) {
 		return NewTable(settings), nil
 	case "pretty":
 		return NewPretty(settings), nil
+	case "rst":
+		return NewRstTable(settings), nil
+	case "rst document", "rst doc":
+		return NewRstDocument(settings), nil
+	case "rst table", "rst tbl":
+		return NewRstTable(settings), nil
+	case "terragrunt":
+		return NewTerragrunt(settings), nil
 	case "tfvars hcl":
 		return NewTfvarsHCL(settings), nil
 	case "tfvars json":
 		return NewTfvarsJSON(settings), nil
 	case "toml":
 		return NewTOML(settings), nil
+	case "usage":
+		return NewUsage(settings), nil
 	case "xml":
 		return NewXML(settings), nil
 	case "yaml":
@@ -76,12 +76,24 @@ func TestFormatFactory(t *testing.T) {
 			expected: "*format.AsciidocTable",
 			wantErr:  false,
 		},
+		{
+			name:     "format factory from name",
+			format:   "html",
+			expected: "*format.HTML",
+			wantErr:  false,
+		},
 		{
 			name:     "format factory from name",
 			format:   "json",
 			expected: "*format.JSON",
 			wantErr:  false,
 		},
+		{
+			name:     "format factory from name",
+			format:   "json-schema",
+			expected: "*format.JSONSchema",
+			wantErr:  false,
+		},
 		{
 			name:     "format factory from name",
 			format:   "markdown",
@@ -148,6 +160,42 @@ func TestFormatFactory(t *testing.T) {
 			expected: "*format.Pretty",
 			wantErr:  false,
 		},
+		{
+			name:     "format factory from name",
+			format:   "rst",
+			expected: "*format.RstTable",
+			wantErr:  false,
+		},
+		{
+			name:     "format factory from name",
+			format:   "rst document",
+			expected: "*format.RstDocument",
+			wantErr:  false,
+		},
+		{
+			name:     "format factory from name",
+			format:   "rst doc",
+			expected: "*format.RstDocument",
+			wantErr:  false,
+		},
+		{
+			name:     "format factory from name",
+			format:   "rst table",
+			expected: "*format.RstTable",
+			wantErr:  false,
+		},
+		{
+			name:     "format factory from name",
+			format:   "rst tbl",
+			expected: "*format.RstTable",
+			wantErr:  false,
+		},
+		{
+			name:     "format factory from name",
+			format:   "terragrunt",
+			expected: "*format.Terragrunt",
+			wantErr:  false,
+		},
 		{
 			name:     "format factory from name",
 			format:   "tfvars hcl",
@@ -166,6 +214,12 @@ func TestFormatFactory(t *testing.T) {
 			expected: "*format.TOML",
 			wantErr:  false,
 		},
+		{
+			name:     "format factory from name",
+			format:   "usage",
+			expected: "*format.Usage",
+			wantErr:  false,
+		},
 		{
 			name:     "format factory from name",
 			format:   "xml",
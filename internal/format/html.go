@@ -0,0 +1,612 @@
+package format
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/terraform-docs/terraform-docs/pkg/print"
+	"github.com/terraform-docs/terraform-docs/pkg/tfconf"
+	"github.com/terraform-docs/terraform-docs/pkg/tmpl"
+)
+
+const (
+	htmlPageTpl = `
+	<!DOCTYPE html>
+	<html lang="en">
+	<head>
+	<meta charset="utf-8">
+	<meta name="viewport" content="width=device-width, initial-scale=1">
+	<title>{{ title .Module.Header }}</title>
+	<style>
+	{{ style }}
+	</style>
+	</head>
+	<body>
+	{{ template "content" . }}
+	</body>
+	</html>
+	`
+
+	htmlContentTpl = `
+	<div class="terraform-docs">
+	{{ template "header" . }}
+	{{ template "usage" . }}
+	{{ template "requirements" . }}
+	{{ template "providers" . }}
+	{{ template "modules" . }}
+	{{ template "resources" . }}
+	{{ template "inputs" . }}
+	{{ template "outputs" . }}
+	{{ template "locals" . }}
+	{{ template "footer" . }}
+	</div>
+	`
+
+	htmlHeaderTpl = `
+	{{ if .Settings.ShowHeader }}
+		{{ with .Module.Header }}
+			<div class="header">
+			{{ markdown . }}
+			</div>
+		{{ end }}
+	{{ end }}
+	`
+
+	htmlFooterTpl = `
+	{{ if .Settings.ShowFooter }}
+		{{ with .Module.Footer }}
+			<div class="footer">
+			{{ markdown . }}
+			</div>
+		{{ end }}
+	{{ end }}
+	`
+
+	htmlRequirementsTpl = `
+	{{ if .Settings.ShowRequirements }}
+		<h{{ heading 0 }} id="requirements">Requirements</h{{ heading 0 }}>
+		{{ if not .Module.Requirements }}
+			<p>No requirements.</p>
+		{{ else }}
+			<table>
+			<thead>
+			<tr><th>Name</th><th>Source</th><th>Version</th></tr>
+			</thead>
+			<tbody>
+			{{ range .Module.Requirements }}
+				<tr><td>{{ html .Name }}</td><td>{{ link (tostring .URL) (tostring .Source) }}</td><td>{{ tostring .Version | html | default "n/a" }}</td></tr>
+			{{ end }}
+			</tbody>
+			</table>
+		{{ end }}
+	{{ end }}
+	`
+
+	htmlProvidersTpl = `
+	{{ if .Settings.ShowProviders }}
+		<h{{ heading 0 }} id="providers">Providers</h{{ heading 0 }}>
+		{{ if not .Module.Providers }}
+			<p>No provider.</p>
+		{{ else }}
+			<table>
+			<thead>
+			<tr><th>Name</th><th>Source</th><th>Version</th><th>Kind</th></tr>
+			</thead>
+			<tbody>
+			{{ range .Module.Providers }}
+				<tr><td>{{ html .FullName }}</td><td>{{ link (tostring .URL) (tostring .Source) }}</td><td>{{ tostring .Version | html | default "n/a" }}</td><td>{{ html .Kind }}</td></tr>
+			{{ end }}
+			</tbody>
+			</table>
+		{{ end }}
+	{{ end }}
+	`
+
+	htmlModulesTpl = `
+	{{ if .Settings.ShowModules }}
+		<h{{ heading 0 }} id="modules">Modules</h{{ heading 0 }}>
+		{{ if not .Module.Modules }}
+			<p>No module.</p>
+		{{ else }}
+			<table>
+			<thead>
+			<tr><th>Name</th><th>Source</th><th>Version</th></tr>
+			</thead>
+			<tbody>
+			{{ range .Module.Modules }}
+				<tr><td>{{ html .Name }}</td><td>{{ html .Source }}</td><td>{{ tostring .Version | html | default "n/a" }}</td></tr>
+			{{ end }}
+			</tbody>
+			</table>
+		{{ end }}
+	{{ end }}
+	`
+
+	htmlResourcesTpl = `
+	{{ if .Settings.ShowResources }}
+		<h{{ heading 0 }} id="resources">Resources</h{{ heading 0 }}>
+		{{ if not .Module.Resources }}
+			<p>No resource.</p>
+		{{ else }}
+			<table>
+			<thead>
+			<tr><th>Name</th><th>Type</th><th>Provider</th><th>Position</th></tr>
+			</thead>
+			<tbody>
+			{{ range .Module.Resources }}
+				<tr><td>{{ link (tostring .URL) .Address }}</td><td>{{ html .Type }}</td><td>{{ html .Provider }}</td><td>{{ html .Position.String }}</td></tr>
+			{{ end }}
+			</tbody>
+			</table>
+		{{ end }}
+	{{ end }}
+	`
+
+	htmlInputsTpl = `
+	{{ if .Settings.ShowInputs }}
+		<h{{ heading 0 }} id="inputs">Inputs</h{{ heading 0 }}>
+		{{ if not .Module.Inputs }}
+			<p>No input.</p>
+		{{ else }}
+			{{ $sensitivity := and .Settings.ShowSensitivity .Module.HasSensitiveInputs }}
+			<table>
+			<thead>
+			<tr><th>Name</th><th>Description</th><th>Type</th><th>Default</th>{{ if .Settings.ShowRequired }}<th>Required</th>{{ end }}{{ if $sensitivity }}<th>Sensitive</th>{{ end }}</tr>
+			</thead>
+			<tbody>
+			{{ range .Module.Inputs }}
+				{{ $anchor := anchor "input" .Name }}
+				<tr id="{{ $anchor }}">
+				<td><a href="#{{ $anchor }}">{{ html .Name }}</a></td>
+				<td>{{ template "deprecated" .Tags }}{{ tostring .Description | inline | default "n/a" }}</td>
+				<td>{{ tostring .Type | code }}</td>
+				<td>{{ defaultValue . }}</td>
+				{{ if $.Settings.ShowRequired }}
+					<td>{{ ternary .Required "yes" "no" }}</td>
+				{{ end }}
+				{{ if $sensitivity }}
+					<td>{{ ternary .Sensitive "yes" "no" }}</td>
+				{{ end }}
+				</tr>
+			{{ end }}
+			</tbody>
+			</table>
+		{{ end }}
+	{{ end }}
+	`
+
+	htmlOutputsTpl = `
+	{{ if .Settings.ShowOutputs }}
+		<h{{ heading 0 }} id="outputs">Outputs</h{{ heading 0 }}>
+		{{ if not .Module.Outputs }}
+			<p>No output.</p>
+		{{ else }}
+			{{ $sensitivity := and .Settings.ShowSensitivity (or .Settings.OutputValues .Module.HasSensitiveOutputs) }}
+			<table>
+			<thead>
+			<tr><th>Name</th><th>Description</th>{{ if .Settings.OutputValues }}<th>Value</th>{{ end }}{{ if $sensitivity }}<th>Sensitive</th>{{ end }}</tr>
+			</thead>
+			<tbody>
+			{{ range .Module.Outputs }}
+				{{ $anchor := anchor "output" .Name }}
+				<tr id="{{ $anchor }}">
+				<td><a href="#{{ $anchor }}">{{ html .Name }}</a></td>
+				<td>{{ template "deprecated" .Tags }}{{ tostring .Description | inline | default "n/a" }}</td>
+				{{ if $.Settings.OutputValues }}
+					<td>{{ value (ternary .Sensitive "<sensitive>" .GetValue) }}</td>
+				{{ end }}
+				{{ if $sensitivity }}
+					<td>{{ ternary .Sensitive "yes" "no" }}</td>
+				{{ end }}
+				</tr>
+			{{ end }}
+			</tbody>
+			</table>
+		{{ end }}
+	{{ end }}
+	`
+
+	htmlUsageTpl = `
+	{{ if .Settings.ShowUsage }}
+		<h{{ heading 0 }} id="usage">Usage</h{{ heading 0 }}>
+		{{ usage .Module }}
+	{{ end }}
+	`
+
+	htmlLocalsTpl = `
+	{{ if .Settings.ShowLocals }}
+		<h{{ heading 0 }} id="locals">Locals</h{{ heading 0 }}>
+		{{ if not .Module.Locals }}
+			<p>No local.</p>
+		{{ else }}
+			<table>
+			<thead>
+			<tr><th>Name</th><th>Description</th><th>Value</th></tr>
+			</thead>
+			<tbody>
+			{{ range .Module.Locals }}
+				{{ $anchor := anchor "local" .Name }}
+				<tr id="{{ $anchor }}">
+				<td><a href="#{{ $anchor }}">{{ html .Name }}</a></td>
+				<td>{{ tostring .Description | inline | default "n/a" }}</td>
+				<td>{{ value .Expression }}</td>
+				</tr>
+			{{ end }}
+			</tbody>
+			</table>
+		{{ end }}
+	{{ end }}
+	`
+
+	htmlDeprecatedTpl = `
+	{{- if .Has "deprecated" -}}
+		<strong class="deprecated">Deprecated</strong>{{ with .Get "deprecated" }}: {{ inline . }}{{ end }}<br>
+	{{- end -}}
+	`
+
+	htmlStyle = `body {
+  margin: 0;
+  padding: 2rem;
+  color: #24292e;
+  font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif;
+  font-size: 16px;
+  line-height: 1.5;
+}
+.terraform-docs {
+  max-width: 1200px;
+  margin: 0 auto;
+}
+.terraform-docs table {
+  width: 100%;
+  margin-bottom: 1rem;
+  border-collapse: collapse;
+}
+.terraform-docs th,
+.terraform-docs td {
+  padding: 6px 13px;
+  border: 1px solid #dfe2e5;
+  text-align: left;
+  vertical-align: top;
+}
+.terraform-docs tr:nth-child(2n) {
+  background-color: #f6f8fa;
+}
+.terraform-docs tr:target {
+  background-color: #fff8c5;
+}
+.terraform-docs code,
+.terraform-docs pre {
+  font-family: SFMono-Regular, Consolas, "Liberation Mono", Menlo, monospace;
+  font-size: 85%;
+}
+.terraform-docs code {
+  padding: 0.2em 0.4em;
+  border-radius: 3px;
+  background-color: rgba(27, 31, 35, 0.05);
+}
+.terraform-docs pre {
+  margin: 0;
+  padding: 8px;
+  overflow: auto;
+  border-radius: 3px;
+  background-color: #f6f8fa;
+}
+.terraform-docs pre code {
+  padding: 0;
+  background-color: transparent;
+}
+.terraform-docs td p {
+  margin: 0;
+}
+.terraform-docs summary {
+  cursor: pointer;
+}
+.terraform-docs .deprecated {
+  color: #cb2431;
+}`
+)
+
+// HTML represents HTML format.
+type HTML struct {
+	template *tmpl.Template
+}
+
+// NewHTML returns new instance of HTML. The output is a standalone page,
+// unless 'settings.Fragment' is set in which case only the content of the
+// page is rendered, to be embedded in another one.
+func NewHTML(settings *print.Settings) *HTML {
+	items := []*tmpl.Item{
+		{
+			Name: "content",
+			Text: htmlContentTpl,
+		}, {
+			Name: "header",
+			Text: htmlHeaderTpl,
+		}, {
+			Name: "footer",
+			Text: htmlFooterTpl,
+		}, {
+			Name: "requirements",
+			Text: htmlRequirementsTpl,
+		}, {
+			Name: "providers",
+			Text: htmlProvidersTpl,
+		}, {
+			Name: "modules",
+			Text: htmlModulesTpl,
+		}, {
+			Name: "resources",
+			Text: htmlResourcesTpl,
+		}, {
+			Name: "inputs",
+			Text: htmlInputsTpl,
+		}, {
+			Name: "outputs",
+			Text: htmlOutputsTpl,
+		}, {
+			Name: "locals",
+			Text: htmlLocalsTpl,
+		}, {
+			Name: "usage",
+			Text: htmlUsageTpl,
+		}, {
+			Name: "deprecated",
+			Text: htmlDeprecatedTpl,
+		},
+	}
+	if !settings.Fragment {
+		items = append([]*tmpl.Item{{Name: "page", Text: htmlPageTpl}}, items...)
+	}
+
+	tt := tmpl.NewTemplate(items...)
+	tt.Settings(settings)
+	tt.CustomFunc(template.FuncMap{
+		"usage": func(m *tfconf.Module) string {
+			return fmt.Sprintf("<pre><code>%s</code></pre>", escapeHTMLBlock(printUsage(m, settings, true, true)))
+		},
+		"title": func(header string) string {
+			return printHTMLTitle(header)
+		},
+		"style": func() string {
+			return htmlStyle
+		},
+		"heading": func(l int) int {
+			level := settings.IndentLevel
+			if level < 1 || level > 5 {
+				level = 2
+			}
+			if level+l > 6 {
+				return 6
+			}
+			return level + l
+		},
+		"anchor": func(prefix string, name string) string {
+			return printHTMLAnchor(prefix, name)
+		},
+		"link": func(url string, text string) string {
+			if text == "" {
+				return "n/a"
+			}
+			if url == "" {
+				return html.EscapeString(text)
+			}
+			return fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(url), html.EscapeString(text))
+		},
+		"markdown": func(s string) string {
+			return printHTMLMarkdown(s, false)
+		},
+		"inline": func(s string) string {
+			return printHTMLMarkdown(s, true)
+		},
+		"code": func(s string) string {
+			return printHTMLCode(s)
+		},
+		"value": func(v string) string {
+			if v == "" {
+				return "n/a"
+			}
+			return printHTMLCollapsible(v)
+		},
+		"defaultValue": func(i *tfconf.Input) string {
+			value, _ := inputDefault(i, settings)
+			if value == "" {
+				return "n/a"
+			}
+			return printHTMLCollapsible(value)
+		},
+	})
+	return &HTML{
+		template: tt,
+	}
+}
+
+// Print prints a Terraform module as HTML.
+func (h *HTML) Print(module *tfconf.Module, settings *print.Settings) (string, error) {
+	rendered, err := h.template.Render(module)
+	if err != nil {
+		return "", err
+	}
+	return sanitizeHTML(rendered), nil
+}
+
+// sanitizeHTML removes the blank lines left over by the template actions. Note
+// that the content of '<pre>' blocks never spans multiple lines, it is always
+// rendered with escaped newlines.
+func sanitizeHTML(s string) string {
+	lines := strings.Split(s, "\n")
+	result := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if strings.TrimSpace(line) != "" {
+			result = append(result, line)
+		}
+	}
+	return strings.Join(result, "\n")
+}
+
+// printHTMLTitle returns the title of the page, which is the text of the first
+// line of module header if it's a heading, or a generic one otherwise.
+func printHTMLTitle(header string) string {
+	line := strings.TrimSpace(strings.SplitN(header, "\n", 2)[0])
+	if m := htmlHeadingPattern.FindStringSubmatch(line); m != nil {
+		return html.EscapeString(m[2])
+	}
+	return "Terraform Module"
+}
+
+// htmlAnchorPattern matches the characters which aren't allowed in the id of
+// an element.
+var htmlAnchorPattern = regexp.MustCompile(`[^a-zA-Z0-9_.-]`)
+
+// printHTMLAnchor returns the id of the element of an item, e.g. an input or
+// an output, with given 'prefix' and 'name'.
+func printHTMLAnchor(prefix string, name string) string {
+	id := htmlAnchorPattern.ReplaceAllString(name, "_")
+	return prefix + "_" + id
+}
+
+// printHTMLCode prints 'code' in '<code>' element if it's a single line, and
+// in '<pre>' block otherwise.
+func printHTMLCode(code string) string {
+	if strings.Contains(code, "\n") {
+		return fmt.Sprintf("<pre><code>%s</code></pre>", escapeHTMLBlock(code))
+	}
+	return fmt.Sprintf("<code>%s</code>", html.EscapeString(code))
+}
+
+// printHTMLCollapsible prints 'code' same as printHTMLCode but multi-line
+// blocks are collapsed by default, e.g. complex default values of inputs.
+func printHTMLCollapsible(code string) string {
+	if strings.Contains(code, "\n") {
+		return fmt.Sprintf("<details><summary>Expand</summary>%s</details>", printHTMLCode(code))
+	}
+	return printHTMLCode(code)
+}
+
+// escapeHTMLBlock escapes the content of a '<pre>' block and its newlines too,
+// for the whole block to be printed in a single line.
+func escapeHTMLBlock(code string) string {
+	return strings.Replace(html.EscapeString(code), "\n", "&#10;", -1)
+}
+
+var (
+	htmlHeadingPattern  = regexp.MustCompile(`^(#{1,6})\s+(.*)$`)
+	htmlListItemPattern = regexp.MustCompile(`^[-*]\s+(.*)$`)
+	htmlCodePattern     = regexp.MustCompile("`([^`]+)`")
+	htmlLinkPattern     = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+	htmlStrongPattern   = regexp.MustCompile(`\*\*([^*]+)\*\*`)
+)
+
+// printHTMLMarkdown converts the commonly used subset of Markdown, found in
+// header and descriptions, to HTML. That is headings, paragraphs, lists and
+// fenced code blocks, as well as inline code, links and strong emphasis. If
+// 'inline' is set and 's' is a single paragraph it is not wrapped in '<p>'.
+func printHTMLMarkdown(s string, inline bool) string {
+	if s == "" {
+		return ""
+	}
+	var blocks []string
+	var paragraph []string
+	var list []string
+	var code []string
+	var fenced bool
+
+	flush := func() {
+		if len(paragraph) > 0 {
+			blocks = append(blocks, "<p>"+strings.Join(paragraph, "\n")+"</p>")
+			paragraph = nil
+		}
+		if len(list) > 0 {
+			blocks = append(blocks, "<ul>\n<li>"+strings.Join(list, "</li>\n<li>")+"</li>\n</ul>")
+			list = nil
+		}
+	}
+
+	for _, line := range strings.Split(strings.Replace(s, "\r\n", "\n", -1), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") {
+			if fenced {
+				blocks = append(blocks, fmt.Sprintf("<pre><code>%s</code></pre>", escapeHTMLBlock(strings.Join(code, "\n"))))
+				code = nil
+			} else {
+				flush()
+			}
+			fenced = !fenced
+			continue
+		}
+		if fenced {
+			code = append(code, line)
+			continue
+		}
+		switch {
+		case trimmed == "":
+			flush()
+		case htmlHeadingPattern.MatchString(trimmed):
+			flush()
+			m := htmlHeadingPattern.FindStringSubmatch(trimmed)
+			blocks = append(blocks, fmt.Sprintf("<h%d>%s</h%d>", len(m[1]), printHTMLInline(m[2]), len(m[1])))
+		case htmlListItemPattern.MatchString(trimmed):
+			if len(paragraph) > 0 {
+				flush()
+			}
+			list = append(list, printHTMLInline(htmlListItemPattern.FindStringSubmatch(trimmed)[1]))
+		default:
+			if len(list) > 0 {
+				flush()
+			}
+			paragraph = append(paragraph, printHTMLInline(trimmed))
+		}
+	}
+	if fenced {
+		blocks = append(blocks, fmt.Sprintf("<pre><code>%s</code></pre>", escapeHTMLBlock(strings.Join(code, "\n"))))
+	}
+	flush()
+
+	if inline && len(blocks) == 1 && strings.HasPrefix(blocks[0], "<p>") {
+		return strings.Replace(strings.TrimSuffix(strings.TrimPrefix(blocks[0], "<p>"), "</p>"), "\n", "<br>", -1)
+	}
+	if inline {
+		return strings.Join(blocks, "")
+	}
+	return strings.Join(blocks, "\n")
+}
+
+// printHTMLInline escapes 's' and converts inline code, links and strong
+// emphasis of Markdown to HTML. Links with unsafe URLs are kept as text.
+func printHTMLInline(s string) string {
+	var codes []string
+	s = htmlCodePattern.ReplaceAllStringFunc(s, func(m string) string {
+		codes = append(codes, html.EscapeString(m[1:len(m)-1]))
+		return fmt.Sprintf("\x00%d\x00", len(codes)-1)
+	})
+	s = html.EscapeString(s)
+	s = htmlLinkPattern.ReplaceAllStringFunc(s, func(m string) string {
+		link := htmlLinkPattern.FindStringSubmatch(m)
+		if !isSafeHTMLURL(link[2]) {
+			return m
+		}
+		return fmt.Sprintf(`<a href="%s">%s</a>`, link[2], link[1])
+	})
+	s = htmlStrongPattern.ReplaceAllString(s, `<strong>$1</strong>`)
+	for i, c := range codes {
+		s = strings.Replace(s, fmt.Sprintf("\x00%d\x00", i), "<code>"+c+"</code>", 1)
+	}
+	return s
+}
+
+// isSafeHTMLURL indicates if 'u' can be used in a link, i.e. it's relative (or
+// an anchor) or its scheme is one of 'http', 'https' or 'mailto'. Any other
+// scheme (e.g. 'javascript') is unsafe to use on a published page.
+func isSafeHTMLURL(u string) bool {
+	i := strings.IndexAny(u, ":/?#")
+	if i == -1 || u[i] != ':' {
+		return true
+	}
+	switch strings.ToLower(u[:i]) {
+	case "http", "https", "mailto":
+		return true
+	}
+	return false
+}
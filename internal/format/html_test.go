@@ -0,0 +1,278 @@
+package format
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/module"
+	"github.com/terraform-docs/terraform-docs/internal/testutil"
+	"github.com/terraform-docs/terraform-docs/pkg/print"
+)
+
+func TestHTML(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().Build()
+
+	expected, err := testutil.GetExpected("html", "html")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewHTML(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestHTMLFragment(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		Fragment: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("html", "html-Fragment")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewHTML(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestHTMLNoHeader(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ShowHeader: false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("html", "html-NoHeader")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewHTML(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestHTMLOnlyInputs(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowHeader:       false,
+		ShowInputs:       true,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("html", "html-OnlyInputs")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewHTML(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestHTMLOnlyLocals(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowLocals:       true,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("html", "html-OnlyLocals")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewHTML(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestHTMLOnlyUsage(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowUsage:        true,
+		ModuleSource:     "terraform-docs/foo/bar",
+	}).Build()
+
+	expected, err := testutil.GetExpected("html", "html-OnlyUsage")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewHTML(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestHTMLIndentationOfFour(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		IndentLevel: 4,
+	}).Build()
+
+	expected, err := testutil.GetExpected("html", "html-IndentationOfFour")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewHTML(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestHTMLOutputValues(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		OutputValues:    true,
+		ShowSensitivity: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("html", "html-OutputValues")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		OutputValues:     true,
+		OutputValuesPath: "output_values.json",
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewHTML(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestHTMLEmpty(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ShowHeader:    false,
+		ShowProviders: false,
+		ShowInputs:    false,
+		ShowOutputs:   false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("html", "html-Empty")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().WithOverwrite(&module.Options{
+		HeaderFromFile: "bad.tf",
+	})
+	options.ShowHeader = false // Since we don't show the header, the file won't be loaded at all
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewHTML(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestPrintHTMLMarkdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		inline   bool
+		expected string
+	}{
+		{
+			name:     "empty",
+			markdown: "",
+			inline:   false,
+			expected: "",
+		},
+		{
+			name:     "single paragraph",
+			markdown: "It's `foo_bar` with [link](https://example.com) and **bold** <text>.",
+			inline:   false,
+			expected: "<p>It&#39;s <code>foo_bar</code> with <a href=\"https://example.com\">link</a> and <strong>bold</strong> &lt;text&gt;.</p>",
+		},
+		{
+			name:     "safe links",
+			markdown: "See [docs](./docs/README.md), [section](#inputs) and [mail](mailto:foo@example.com).",
+			inline:   true,
+			expected: "See <a href=\"./docs/README.md\">docs</a>, <a href=\"#inputs\">section</a> and <a href=\"mailto:foo@example.com\">mail</a>.",
+		},
+		{
+			name:     "unsafe links",
+			markdown: "Click [here](javascript:alert(1)) or [there](JavaScript:void) or [data](data:text/html;base64,PHNjcmlwdD4=).",
+			inline:   true,
+			expected: "Click [here](javascript:alert(1)) or [there](JavaScript:void) or [data](data:text/html;base64,PHNjcmlwdD4=).",
+		},
+		{
+			name:     "single inline paragraph",
+			markdown: "first line\nsecond line",
+			inline:   true,
+			expected: "first line<br>second line",
+		},
+		{
+			name:     "heading and list",
+			markdown: "# Title\n\nSome text:\n- item 1\n- item 2",
+			inline:   false,
+			expected: "<h1>Title</h1>\n<p>Some text:</p>\n<ul>\n<li>item 1</li>\n<li>item 2</li>\n</ul>",
+		},
+		{
+			name:     "fenced code block",
+			markdown: "Example:\n\n```hcl\nfoo = \"bar\"\nbaz = [1]\n```",
+			inline:   true,
+			expected: "<p>Example:</p><pre><code>foo = &#34;bar&#34;&#10;baz = [1]</code></pre>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := printHTMLMarkdown(tt.markdown, tt.inline)
+
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,280 @@
+package format
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/iancoleman/orderedmap"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
+
+	"github.com/terraform-docs/terraform-docs/pkg/print"
+	"github.com/terraform-docs/terraform-docs/pkg/tfconf"
+)
+
+// jsonSchemaDialect is the JSON Schema draft the generated document conforms to.
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// JSONSchema represents JSON Schema format.
+type JSONSchema struct{}
+
+// NewJSONSchema returns new instance of JSONSchema.
+func NewJSONSchema(settings *print.Settings) *JSONSchema {
+	return &JSONSchema{}
+}
+
+// Print prints a Terraform module as JSON Schema document of its inputs, which
+// can be used to validate Terraform tfvars JSON documents.
+func (j *JSONSchema) Print(module *tfconf.Module, settings *print.Settings) (string, error) {
+	properties := orderedmap.New()
+	required := []string{}
+	for _, i := range module.Inputs {
+		properties.Set(i.Name, inputSchema(i))
+		if i.Required {
+			required = append(required, i.Name)
+		}
+	}
+
+	schema := orderedmap.New()
+	schema.Set("$schema", jsonSchemaDialect)
+	schema.Set("type", "object")
+	schema.Set("properties", properties)
+	if len(required) > 0 {
+		schema.Set("required", required)
+	}
+	schema.Set("additionalProperties", false)
+
+	buffer := new(bytes.Buffer)
+
+	encoder := json.NewEncoder(buffer)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+
+	err := encoder.Encode(schema)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buffer.String(), "\n"), nil
+}
+
+// inputSchema returns the schema of the value of 'input', based on the structure
+// of its declared type, annotated with its description and its default value if
+// it isn't required. Optional inputs also accept null, unless they are declared
+// with 'nullable = false', as Terraform doesn't accept null for required ones.
+func inputSchema(input *tfconf.Input) *orderedmap.OrderedMap {
+	schema := orderedmap.New()
+	if description := strings.TrimSpace(string(input.Description)); description != "" {
+		schema.Set("description", description)
+	}
+	if input.TypeSource != "" {
+		typeSchema(input.Structure, schema)
+	}
+	if !input.Required && input.Nullable {
+		nullableSchema(schema)
+	}
+	if !input.Required {
+		if value, ok := inputSchemaDefault(input); ok {
+			schema.Set("default", value)
+		}
+	}
+	if input.Tags.Has("deprecated") {
+		schema.Set("deprecated", true)
+	}
+	return schema
+}
+
+// typeSchema adds the keywords of the schema of Terraform type 't' to 'schema'.
+// Nothing is added for 'any' type (or if the type is unknown), as any value is
+// valid then. For example:
+//
+//	list(string)            {"type": "array", "items": {"type": "string"}}
+//	map(number)             {"type": "object", "additionalProperties": {"type": "number"}}
+//	object({ a = string })  {"type": "object", "properties": {...}, "required": ["a"]}
+func typeSchema(t *tfconf.Type, schema *orderedmap.OrderedMap) {
+	if t == nil {
+		return
+	}
+	switch t.Kind {
+	case "string":
+		schema.Set("type", "string")
+	case "number":
+		schema.Set("type", "number")
+	case "bool":
+		schema.Set("type", "boolean")
+	case "list", "set":
+		schema.Set("type", "array")
+		if t.Element != nil {
+			schema.Set("items", elementSchema(t.Element))
+		}
+		if t.Kind == "set" {
+			schema.Set("uniqueItems", true)
+		}
+	case "map":
+		schema.Set("type", "object")
+		if t.Element != nil {
+			schema.Set("additionalProperties", elementSchema(t.Element))
+		}
+	case "tuple":
+		items := make([]*orderedmap.OrderedMap, len(t.Elements))
+		for i, element := range t.Elements {
+			items[i] = elementSchema(element)
+		}
+		schema.Set("type", "array")
+		schema.Set("prefixItems", items)
+		schema.Set("minItems", len(items))
+		schema.Set("items", false)
+	case "object":
+		properties := orderedmap.New()
+		required := []string{}
+		for _, attribute := range t.Attributes {
+			properties.Set(attribute.Name, attributeSchema(attribute))
+			if !attribute.Optional {
+				required = append(required, attribute.Name)
+			}
+		}
+		schema.Set("type", "object")
+		if len(t.Attributes) > 0 {
+			schema.Set("properties", properties)
+		}
+		if len(required) > 0 {
+			schema.Set("required", required)
+		}
+	}
+}
+
+// nullableSchema adds null to the allowed types of 'schema', if it's restricted
+// to any type at all.
+func nullableSchema(schema *orderedmap.OrderedMap) {
+	if t, ok := schema.Get("type"); ok {
+		schema.Set("type", []interface{}{t, "null"})
+	}
+}
+
+func elementSchema(t *tfconf.Type) *orderedmap.OrderedMap {
+	schema := orderedmap.New()
+	typeSchema(t, schema)
+	return schema
+}
+
+// attributeSchema returns the schema of an attribute of object type, annotated
+// with its description and the default value of 'optional()' modifier if it's
+// a literal value. Optional attributes also accept null, same as omitting them.
+func attributeSchema(attribute *tfconf.TypeAttribute) *orderedmap.OrderedMap {
+	schema := orderedmap.New()
+	if description := strings.TrimSpace(string(attribute.Description)); description != "" {
+		schema.Set("description", description)
+	}
+	typeSchema(attribute.Type, schema)
+	if attribute.Optional {
+		nullableSchema(schema)
+	}
+	if attribute.Optional && attribute.Default != "" {
+		if value, ok := attributeDefault(attribute); ok {
+			schema.Set("default", value)
+		}
+	}
+	return schema
+}
+
+// inputSchemaDefault returns the JSON representation of the default value of
+// 'input' converted to its declared type, the same way Terraform converts it
+// (e.g. "19" to 19 for number type). It returns false if the value can't be
+// converted, or if it's null and the input isn't nullable.
+func inputSchemaDefault(input *tfconf.Input) (json.RawMessage, bool) {
+	raw, err := json.Marshal(input.Default)
+	if err != nil {
+		return nil, false
+	}
+	implied, err := ctyjson.ImpliedType(raw)
+	if err != nil {
+		return nil, false
+	}
+	value, err := ctyjson.Unmarshal(raw, implied)
+	if err != nil {
+		return nil, false
+	}
+	if value.IsNull() && !input.Nullable {
+		return nil, false
+	}
+	if input.TypeSource == "" {
+		return convertedDefault(value, nil)
+	}
+	return convertedDefault(value, input.Structure)
+}
+
+// attributeDefault evaluates the raw source of the default value of an attribute
+// and returns its JSON representation, converted to the type of the attribute.
+// It returns false if the source isn't a literal value (e.g. it's referencing a
+// variable or calling a function) or if it can't be converted.
+func attributeDefault(attribute *tfconf.TypeAttribute) (json.RawMessage, bool) {
+	expr, diags := hclsyntax.ParseExpression([]byte(attribute.Default), "", hcl.Pos{Line: 1, Column: 1})
+	if diags.HasErrors() {
+		return nil, false
+	}
+	value, diags := expr.Value(nil)
+	if diags.HasErrors() || !value.IsWhollyKnown() {
+		return nil, false
+	}
+	return convertedDefault(value, attribute.Type)
+}
+
+// convertedDefault converts 'value' to Terraform type 't' and returns its JSON
+// representation, or false if it can't be converted.
+func convertedDefault(value cty.Value, t *tfconf.Type) (json.RawMessage, bool) {
+	converted, err := convert.Convert(value, ctyType(t))
+	if err != nil {
+		return nil, false
+	}
+	raw, err := ctyjson.Marshal(converted, converted.Type())
+	if err != nil {
+		return nil, false
+	}
+	return json.RawMessage(raw), true
+}
+
+// ctyType returns the cty type of Terraform type 't'. Dynamic type is returned
+// for 'any' type (or if the type is unknown), to which any value converts.
+func ctyType(t *tfconf.Type) cty.Type {
+	if t == nil {
+		return cty.DynamicPseudoType
+	}
+	switch t.Kind {
+	case "string":
+		return cty.String
+	case "number":
+		return cty.Number
+	case "bool":
+		return cty.Bool
+	case "list":
+		return cty.List(ctyType(t.Element))
+	case "set":
+		return cty.Set(ctyType(t.Element))
+	case "map":
+		return cty.Map(ctyType(t.Element))
+	case "tuple":
+		elements := make([]cty.Type, len(t.Elements))
+		for i, element := range t.Elements {
+			elements[i] = ctyType(element)
+		}
+		return cty.Tuple(elements)
+	case "object":
+		attributes := make(map[string]cty.Type, len(t.Attributes))
+		optional := []string{}
+		for _, attribute := range t.Attributes {
+			attributes[attribute.Name] = ctyType(attribute.Type)
+			if attribute.Optional {
+				optional = append(optional, attribute.Name)
+			}
+		}
+		if len(optional) > 0 {
+			return cty.ObjectWithOptionalAttrs(attributes, optional)
+		}
+		return cty.Object(attributes)
+	}
+	return cty.DynamicPseudoType
+}
@@ -0,0 +1,269 @@
+package format
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/iancoleman/orderedmap"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/module"
+	"github.com/terraform-docs/terraform-docs/internal/testutil"
+	"github.com/terraform-docs/terraform-docs/internal/types"
+	"github.com/terraform-docs/terraform-docs/pkg/print"
+	"github.com/terraform-docs/terraform-docs/pkg/tfconf"
+)
+
+func TestJSONSchema(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().Build()
+
+	expected, err := testutil.GetExpected("json-schema", "json-schema")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewJSONSchema(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestJSONSchemaSortByName(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		SortByName: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("json-schema", "json-schema-SortByName")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		SortBy: &module.SortBy{
+			Name: true,
+		},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewJSONSchema(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestJSONSchemaSortByRequired(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		SortByName:     true,
+		SortByRequired: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("json-schema", "json-schema-SortByRequired")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		SortBy: &module.SortBy{
+			Name:     true,
+			Required: true,
+		},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewJSONSchema(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestTypeSchema(t *testing.T) {
+	tests := []struct {
+		name     string
+		typ      *tfconf.Type
+		expected string
+	}{
+		{
+			name:     "any type",
+			typ:      nil,
+			expected: `{}`,
+		},
+		{
+			name:     "primitive type",
+			typ:      &tfconf.Type{Kind: "bool"},
+			expected: `{"type":"boolean"}`,
+		},
+		{
+			name:     "list type",
+			typ:      &tfconf.Type{Kind: "list", Element: &tfconf.Type{Kind: "string"}},
+			expected: `{"type":"array","items":{"type":"string"}}`,
+		},
+		{
+			name:     "set type",
+			typ:      &tfconf.Type{Kind: "set", Element: &tfconf.Type{Kind: "number"}},
+			expected: `{"type":"array","items":{"type":"number"},"uniqueItems":true}`,
+		},
+		{
+			name:     "map type",
+			typ:      &tfconf.Type{Kind: "map", Element: &tfconf.Type{Kind: "number"}},
+			expected: `{"type":"object","additionalProperties":{"type":"number"}}`,
+		},
+		{
+			name:     "map type without element type",
+			typ:      &tfconf.Type{Kind: "map"},
+			expected: `{"type":"object"}`,
+		},
+		{
+			name: "tuple type",
+			typ: &tfconf.Type{
+				Kind:     "tuple",
+				Elements: []*tfconf.Type{{Kind: "string"}, {Kind: "any"}},
+			},
+			expected: `{"type":"array","prefixItems":[{"type":"string"},{}],"minItems":2,"items":false}`,
+		},
+		{
+			name: "object type",
+			typ: &tfconf.Type{
+				Kind: "object",
+				Attributes: []*tfconf.TypeAttribute{
+					{Name: "name", Type: &tfconf.Type{Kind: "string"}, Description: "name of the rule"},
+					{Name: "ports", Type: &tfconf.Type{Kind: "list", Element: &tfconf.Type{Kind: "number"}}, Optional: true, Default: "[80, 443]"},
+					{Name: "tags", Type: &tfconf.Type{Kind: "map", Element: &tfconf.Type{Kind: "string"}}, Optional: true, Default: "var.tags"},
+				},
+			},
+			expected: `{"type":"object","properties":{"name":{"description":"name of the rule","type":"string"},"ports":{"type":["array","null"],"items":{"type":"number"},"default":[80,443]},"tags":{"type":["object","null"],"additionalProperties":{"type":"string"}}},"required":["name"]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			schema := orderedmap.New()
+			typeSchema(tt.typ, schema)
+
+			actual, err := json.Marshal(schema)
+
+			assert.Nil(err)
+			assert.Equal(tt.expected, string(actual))
+		})
+	}
+}
+
+func TestInputSchema(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    *tfconf.Input
+		expected string
+	}{
+		{
+			name: "required input",
+			input: &tfconf.Input{
+				TypeSource: "string",
+				Structure:  &tfconf.Type{Kind: "string"},
+				Default:    types.ValueOf(nil),
+				Required:   true,
+				Nullable:   true,
+			},
+			expected: `{"type":"string"}`,
+		},
+		{
+			name: "optional input",
+			input: &tfconf.Input{
+				TypeSource: "string",
+				Structure:  &tfconf.Type{Kind: "string"},
+				Default:    types.ValueOf("foo"),
+				Nullable:   true,
+			},
+			expected: `{"type":["string","null"],"default":"foo"}`,
+		},
+		{
+			name: "optional non-nullable input",
+			input: &tfconf.Input{
+				TypeSource: "string",
+				Structure:  &tfconf.Type{Kind: "string"},
+				Default:    types.ValueOf("foo"),
+				Nullable:   false,
+			},
+			expected: `{"type":"string","default":"foo"}`,
+		},
+		{
+			name: "optional input with null default",
+			input: &tfconf.Input{
+				TypeSource: "string",
+				Structure:  &tfconf.Type{Kind: "string"},
+				Default:    types.ValueOf(nil),
+				Nullable:   true,
+			},
+			expected: `{"type":["string","null"],"default":null}`,
+		},
+		{
+			name: "optional non-nullable input with null default",
+			input: &tfconf.Input{
+				TypeSource: "string",
+				Structure:  &tfconf.Type{Kind: "string"},
+				Default:    types.ValueOf(nil),
+				Nullable:   false,
+			},
+			expected: `{"type":"string"}`,
+		},
+		{
+			name: "default converted to declared type",
+			input: &tfconf.Input{
+				TypeSource: "number",
+				Structure:  &tfconf.Type{Kind: "number"},
+				Default:    types.ValueOf("19"),
+				Nullable:   true,
+			},
+			expected: `{"type":["number","null"],"default":19}`,
+		},
+		{
+			name: "default not convertible to declared type",
+			input: &tfconf.Input{
+				TypeSource: "number",
+				Structure:  &tfconf.Type{Kind: "number"},
+				Default:    types.ValueOf("foo"),
+				Nullable:   true,
+			},
+			expected: `{"type":["number","null"]}`,
+		},
+		{
+			name: "input with heredoc description",
+			input: &tfconf.Input{
+				TypeSource:  "string",
+				Structure:   &tfconf.Type{Kind: "string"},
+				Description: types.String("Config object.\n"),
+				Default:     types.ValueOf(nil),
+				Required:    true,
+				Nullable:    true,
+			},
+			expected: `{"description":"Config object.","type":"string"}`,
+		},
+		{
+			name: "input without declared type",
+			input: &tfconf.Input{
+				Structure: &tfconf.Type{Kind: "string"},
+				Default:   types.ValueOf("foo"),
+				Nullable:  true,
+			},
+			expected: `{"default":"foo"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			actual, err := json.Marshal(inputSchema(tt.input))
+
+			assert.Nil(err)
+			assert.Equal(tt.expected, string(actual))
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package format
 
 import (
+	"fmt"
 	"text/template"
 
 	"github.com/terraform-docs/terraform-docs/pkg/print"
@@ -18,16 +19,26 @@ const (
 	{{ end -}}
 	`
 
+	tableFooterTpl = `
+	{{- if .Settings.ShowFooter -}}
+		{{- with .Module.Footer -}}
+			{{ sanitizeHeader . }}
+			{{ printf "\n" }}
+		{{- end -}}
+	{{ end -}}
+	`
+
 	tableRequirementsTpl = `
 	{{- if .Settings.ShowRequirements -}}
 		{{ indent 0 "#" }} Requirements
 		{{ if not .Module.Requirements }}
 			No requirements.
 		{{ else }}
-			| Name | Version |
-			|------|---------|
+			| Name | Source | Version |
+			|------|--------|---------|
 			{{- range .Module.Requirements }}
-				| {{ name .Name }} | {{ tostring .Version | default "n/a" }} |
+				{{- $source := ternary (tostring .URL) (printf "[%s](%s)" .Source .URL) (tostring .Source) }}
+				| {{ name .Name }} | {{ default "n/a" $source }} | {{ tostring .Version | default "n/a" }} |
 			{{- end }}
 		{{ end }}
 	{{ end -}}
@@ -39,10 +50,42 @@ const (
 		{{ if not .Module.Providers }}
 			No provider.
 		{{ else }}
-			| Name | Version |
-			|------|---------|
+			| Name | Source | Version | Kind |
+			|------|--------|---------|------|
 			{{- range .Module.Providers }}
-				| {{ name .FullName }} | {{ tostring .Version | default "n/a" }} |
+				{{- $source := ternary (tostring .URL) (printf "[%s](%s)" .Source .URL) (tostring .Source) }}
+				| {{ name .FullName }} | {{ default "n/a" $source }} | {{ tostring .Version | default "n/a" }} | {{ .Kind }} |
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	tableModulesTpl = `
+	{{- if .Settings.ShowModules -}}
+		{{ indent 0 "#" }} Modules
+		{{ if not .Module.Modules }}
+			No module.
+		{{ else }}
+			| Name | Source | Version |
+			|------|--------|---------|
+			{{- range .Module.Modules }}
+				| {{ name .Name }} | {{ .Source | sanitizeTbl }} | {{ tostring .Version | default "n/a" }} |
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	tableResourcesTpl = `
+	{{- if .Settings.ShowResources -}}
+		{{ indent 0 "#" }} Resources
+		{{ if not .Module.Resources }}
+			No resource.
+		{{ else }}
+			| Name | Type | Provider | Position |
+			|------|------|----------|----------|
+			{{- range .Module.Resources }}
+				{{- $address := name .Address }}
+				| {{ ternary (tostring .URL) (printf "[%s](%s)" $address .URL) $address }} | {{ name .Type }} | {{ name .Provider }} | {{ name .Position.String }} |
 			{{- end }}
 		{{ end }}
 	{{ end -}}
@@ -54,13 +97,17 @@ const (
 		{{ if not .Module.Inputs }}
 			No input.
 		{{ else }}
-			| Name | Description | Type | Default |{{ if .Settings.ShowRequired }} Required |{{ end }}
-			|------|-------------|------|---------|{{ if .Settings.ShowRequired }}:--------:|{{ end }}
+			{{- $sensitivity := and .Settings.ShowSensitivity .Module.HasSensitiveInputs }}
+			| Name | Description | Type | Default |{{ if .Settings.ShowRequired }} Required |{{ end }}{{ if $sensitivity }} Sensitive |{{ end }}
+			|------|-------------|------|---------|{{ if .Settings.ShowRequired }}:--------:|{{ end }}{{ if $sensitivity }}:---------:|{{ end }}
 			{{- range .Module.Inputs }}
-				| {{ name .Name }} | {{ tostring .Description | sanitizeTbl }} | {{ tostring .Type | type | sanitizeTbl }} | {{ value .GetValue | sanitizeTbl }} |
+				| {{ name .Name }} | {{ if .Tags.Has "deprecated" }}**Deprecated**{{ with .Tags.Get "deprecated" }}: {{ sanitizeTbl . }}{{ end }}{{ with tostring .Description }}<br>{{ sanitizeTbl . }}{{ end }}{{ else }}{{ tostring .Description | sanitizeTbl }}{{ end }} | {{ tostring .Type | type | sanitizeTbl }} | {{ value (defaultValue .) | sanitizeTbl }} |
 				{{- if $.Settings.ShowRequired -}}
 					{{ printf " " }}{{ ternary .Required "yes" "no" }} |
 				{{- end -}}
+				{{- if $sensitivity -}}
+					{{ printf " " }}{{ ternary .Sensitive "yes" "no" }} |
+				{{- end -}}
 			{{- end }}
 		{{ end }}
 	{{ end -}}
@@ -72,28 +119,58 @@ const (
 		{{ if not .Module.Outputs }}
 			No output.
 		{{ else }}
-			| Name | Description |{{ if .Settings.OutputValues }} Value |{{ if $.Settings.ShowSensitivity }} Sensitive |{{ end }}{{ end }}
-			|------|-------------|{{ if .Settings.OutputValues }}-------|{{ if $.Settings.ShowSensitivity }}:---------:|{{ end }}{{ end }}
+			{{- $sensitivity := and .Settings.ShowSensitivity (or .Settings.OutputValues .Module.HasSensitiveOutputs) }}
+			| Name | Description |{{ if .Settings.OutputValues }} Value |{{ end }}{{ if $sensitivity }} Sensitive |{{ end }}
+			|------|-------------|{{ if .Settings.OutputValues }}-------|{{ end }}{{ if $sensitivity }}:---------:|{{ end }}
 			{{- range .Module.Outputs }}
-				| {{ name .Name }} | {{ tostring .Description | sanitizeTbl }} |
+				| {{ name .Name }} | {{ if .Tags.Has "deprecated" }}**Deprecated**{{ with .Tags.Get "deprecated" }}: {{ sanitizeTbl . }}{{ end }}{{ with tostring .Description }}<br>{{ sanitizeTbl . }}{{ end }}{{ else }}{{ tostring .Description | sanitizeTbl }}{{ end }} |
 				{{- if $.Settings.OutputValues -}}
 					{{- $sensitive := ternary .Sensitive "<sensitive>" .GetValue -}}
 					{{ printf " " }}{{ value $sensitive | sanitizeTbl }} |
-					{{- if $.Settings.ShowSensitivity -}}
-						{{ printf " " }}{{ ternary .Sensitive "yes" "no" }} |
-					{{- end -}}
+				{{- end -}}
+				{{- if $sensitivity -}}
+					{{ printf " " }}{{ ternary .Sensitive "yes" "no" }} |
 				{{- end -}}
 			{{- end }}
 		{{ end }}
 	{{ end -}}
 	`
 
+	tableLocalsTpl = `
+	{{- if .Settings.ShowLocals -}}
+		{{ indent 0 "#" }} Locals
+		{{ if not .Module.Locals }}
+			No local.
+		{{ else }}
+			| Name | Description | Value |
+			|------|-------------|-------|
+			{{- range .Module.Locals }}
+				| {{ name .Name }} | {{ tostring .Description | sanitizeTbl }} | {{ .Expression | type | sanitizeTbl }} |
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	tableUsageTpl = `
+	{{- if .Settings.ShowUsage -}}
+		{{ indent 0 "#" }} Usage
+
+		{{ usage .Module }}
+		{{ printf "\n" }}
+	{{ end -}}
+	`
+
 	tableTpl = `
 	{{- template "header" . -}}
+	{{- template "usage" . -}}
 	{{- template "requirements" . -}}
 	{{- template "providers" . -}}
+	{{- template "modules" . -}}
+	{{- template "resources" . -}}
 	{{- template "inputs" . -}}
 	{{- template "outputs" . -}}
+	{{- template "locals" . -}}
+	{{- template "footer" . -}}
 	`
 )
 
@@ -110,21 +187,39 @@ func NewTable(settings *print.Settings) *Table {
 	}, &tmpl.Item{
 		Name: "header",
 		Text: tableHeaderTpl,
+	}, &tmpl.Item{
+		Name: "footer",
+		Text: tableFooterTpl,
 	}, &tmpl.Item{
 		Name: "requirements",
 		Text: tableRequirementsTpl,
 	}, &tmpl.Item{
 		Name: "providers",
 		Text: tableProvidersTpl,
+	}, &tmpl.Item{
+		Name: "modules",
+		Text: tableModulesTpl,
+	}, &tmpl.Item{
+		Name: "resources",
+		Text: tableResourcesTpl,
 	}, &tmpl.Item{
 		Name: "inputs",
 		Text: tableInputsTpl,
 	}, &tmpl.Item{
 		Name: "outputs",
 		Text: tableOutputsTpl,
+	}, &tmpl.Item{
+		Name: "locals",
+		Text: tableLocalsTpl,
+	}, &tmpl.Item{
+		Name: "usage",
+		Text: tableUsageTpl,
 	})
 	tt.Settings(settings)
 	tt.CustomFunc(template.FuncMap{
+		"usage": func(m *tfconf.Module) string {
+			return fmt.Sprintf("```hcl\n%s\n```", printUsage(m, settings, true, true))
+		},
 		"type": func(t string) string {
 			inputType, _ := printFencedCodeBlock(t, "")
 			return inputType
@@ -136,6 +231,10 @@ func NewTable(settings *print.Settings) *Table {
 			}
 			return result
 		},
+		"defaultValue": func(i *tfconf.Input) string {
+			value, _ := inputDefault(i, settings)
+			return value
+		},
 	})
 	return &Table{
 		template: tt,
@@ -26,7 +26,8 @@ const (
 			{{- printf "\n" -}}
 			{{- range . }}
 				{{- $version := ternary (tostring .Version) (printf " (%s)" .Version) "" }}
-				{{ printf "requirement.%s" .Name | colorize "\033[36m" }}{{ $version }}
+				{{- $source := ternary (tostring .Source) (printf " from %s" .Source) "" }}
+				{{ printf "requirement.%s" .Name | colorize "\033[36m" }}{{ $version }}{{ $source }}
 			{{ end }}
 			{{- printf "\n" -}}
 		{{ end -}}
@@ -39,7 +40,33 @@ const (
 			{{- printf "\n" -}}
 			{{- range . }}
 				{{- $version := ternary (tostring .Version) (printf " (%s)" .Version) "" }}
-				{{ printf "provider.%s" .FullName | colorize "\033[36m" }}{{ $version }}
+				{{- $source := ternary (tostring .Source) (printf " from %s" .Source) "" }}
+				{{ printf "provider.%s" .FullName | colorize "\033[36m" }}{{ $version }}{{ $source }} ({{ .Kind }})
+			{{ end }}
+			{{- printf "\n" -}}
+		{{ end -}}
+	{{ end -}}
+	`
+
+	prettyModulesTpl = `
+	{{- if .Settings.ShowModules -}}
+		{{- with .Module.Modules }}
+			{{- printf "\n" -}}
+			{{- range . }}
+				{{- $version := ternary (tostring .Version) (printf " (%s)" .Version) "" }}
+				{{ printf "module.%s" .Name | colorize "\033[36m" }} ({{ .Source }}){{ $version }}
+			{{ end }}
+			{{- printf "\n" -}}
+		{{ end -}}
+	{{ end -}}
+	`
+
+	prettyResourcesTpl = `
+	{{- if .Settings.ShowResources -}}
+		{{- with .Module.Resources }}
+			{{- printf "\n" -}}
+			{{- range . }}
+				{{ colorize "\033[36m" .Address }} ({{ .Provider }})
 			{{ end }}
 			{{- printf "\n" -}}
 		{{ end -}}
@@ -51,7 +78,7 @@ const (
 		{{- with .Module.Inputs }}
 			{{- printf "\n" -}}
 			{{- range . }}
-				{{ printf "input.%s" .Name | colorize "\033[36m" }} ({{ default "required" .GetValue }})
+				{{ printf "input.%s" .Name | colorize "\033[36m" }} ({{ default "required" (defaultValue .) }})
 				{{ tostring .Description | trimSuffix "\n" | default "n/a" | colorize "\033[90m" }}
 			{{ end }}
 			{{- printf "\n" -}}
@@ -75,12 +102,27 @@ const (
 	{{ end -}}
 	`
 
+	prettyLocalsTpl = `
+	{{- if .Settings.ShowLocals -}}
+		{{- with .Module.Locals }}
+			{{- printf "\n" -}}
+			{{- range . }}
+				{{ printf "local.%s" .Name | colorize "\033[36m" }} ({{ .Expression }})
+				{{ tostring .Description | trimSuffix "\n" | default "n/a" | colorize "\033[90m" }}
+			{{ end }}
+		{{ end -}}
+	{{ end -}}
+	`
+
 	prettyTpl = `
 	{{- template "header" . -}}
 	{{- template "requirements" . -}}
 	{{- template "providers" . -}}
+	{{- template "modules" . -}}
+	{{- template "resources" . -}}
 	{{- template "inputs" . -}}
 	{{- template "outputs" . -}}
+	{{- template "locals" . -}}
 	`
 )
 
@@ -103,12 +145,21 @@ func NewPretty(settings *print.Settings) *Pretty {
 	}, &tmpl.Item{
 		Name: "providers",
 		Text: prettyProvidersTpl,
+	}, &tmpl.Item{
+		Name: "modules",
+		Text: prettyModulesTpl,
+	}, &tmpl.Item{
+		Name: "resources",
+		Text: prettyResourcesTpl,
 	}, &tmpl.Item{
 		Name: "inputs",
 		Text: prettyInputsTpl,
 	}, &tmpl.Item{
 		Name: "outputs",
 		Text: prettyOutputsTpl,
+	}, &tmpl.Item{
+		Name: "locals",
+		Text: prettyLocalsTpl,
 	})
 	tt.Settings(settings)
 	tt.CustomFunc(template.FuncMap{
@@ -120,6 +171,10 @@ func NewPretty(settings *print.Settings) *Pretty {
 			}
 			return fmt.Sprintf("%s%s%s", c, s, r)
 		},
+		"defaultValue": func(i *tfconf.Input) string {
+			value, _ := inputDefault(i, settings)
+			return value
+		},
 	})
 	return &Pretty{
 		template: tt,
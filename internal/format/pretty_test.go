@@ -113,9 +113,11 @@ func TestPrettyNoHeader(t *testing.T) {
 	settings := testutil.Settings().WithColor().With(&print.Settings{
 		ShowHeader:       false,
 		ShowInputs:       true,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    true,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("pretty", "pretty-NoHeader")
@@ -137,9 +139,11 @@ func TestPrettyNoInputs(t *testing.T) {
 	settings := testutil.Settings().WithColor().With(&print.Settings{
 		ShowHeader:       true,
 		ShowInputs:       false,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    true,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("pretty", "pretty-NoInputs")
@@ -161,9 +165,11 @@ func TestPrettyNoOutputs(t *testing.T) {
 	settings := testutil.Settings().WithColor().With(&print.Settings{
 		ShowHeader:       true,
 		ShowInputs:       true,
+		ShowModules:      true,
 		ShowOutputs:      false,
 		ShowProviders:    true,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("pretty", "pretty-NoOutputs")
@@ -185,9 +191,11 @@ func TestPrettyNoProviders(t *testing.T) {
 	settings := testutil.Settings().WithColor().With(&print.Settings{
 		ShowHeader:       true,
 		ShowInputs:       true,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    false,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("pretty", "pretty-NoProviders")
@@ -204,14 +212,68 @@ func TestPrettyNoProviders(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
+func TestPrettyNoModules(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithColor().With(&print.Settings{
+		ShowHeader:       true,
+		ShowInputs:       true,
+		ShowModules:      false,
+		ShowOutputs:      true,
+		ShowProviders:    true,
+		ShowRequirements: true,
+		ShowResources:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("pretty", "pretty-NoModules")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewPretty(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestPrettyNoResources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithColor().With(&print.Settings{
+		ShowHeader:       true,
+		ShowInputs:       true,
+		ShowModules:      true,
+		ShowOutputs:      true,
+		ShowProviders:    true,
+		ShowRequirements: true,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("pretty", "pretty-NoResources")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewPretty(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
 func TestPrettyNoRequirements(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().WithColor().With(&print.Settings{
 		ShowHeader:       true,
 		ShowInputs:       true,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    true,
 		ShowRequirements: false,
+		ShowResources:    true,
 	}).Build()
 
 	expected, err := testutil.GetExpected("pretty", "pretty-NoRequirements")
@@ -276,6 +338,33 @@ func TestPrettyOnlyInputs(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
+func TestPrettyOnlyLocals(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithColor().With(&print.Settings{
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowLocals:       true,
+		ShowModules:      false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("pretty", "pretty-OnlyLocals")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewPretty(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
 func TestPrettyOnlyOutputs(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().WithColor().With(&print.Settings{
@@ -324,6 +413,57 @@ func TestPrettyOnlyProviders(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
+func TestPrettyOnlyModules(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithColor().With(&print.Settings{
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      true,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+	}).Build()
+
+	expected, err := testutil.GetExpected("pretty", "pretty-OnlyModules")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewPretty(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestPrettyOnlyResources(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithColor().With(&print.Settings{
+		ShowHeader:       false,
+		ShowInputs:       false,
+		ShowModules:      false,
+		ShowOutputs:      false,
+		ShowProviders:    false,
+		ShowRequirements: false,
+		ShowResources:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("pretty", "pretty-OnlyResources")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewPretty(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
 func TestPrettyOnlyRequirements(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().WithColor().With(&print.Settings{
@@ -445,6 +585,46 @@ func TestPrettyHeaderFromFile(t *testing.T) {
 	}
 }
 
+func TestPrettyDefaultSource(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		DefaultSource: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("pretty", "pretty-DefaultSource")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewPretty(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestPrettyDefaultHCL(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		DefaultHCL: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("pretty", "pretty-DefaultHCL")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewPretty(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
 func TestPrettyEmpty(t *testing.T) {
 	assert := assert.New(t)
 	settings := testutil.Settings().With(&print.Settings{
@@ -0,0 +1,401 @@
+package format
+
+import (
+	"fmt"
+	"text/template"
+
+	"github.com/terraform-docs/terraform-docs/pkg/print"
+	"github.com/terraform-docs/terraform-docs/pkg/tfconf"
+	"github.com/terraform-docs/terraform-docs/pkg/tmpl"
+)
+
+const (
+	rstDocumentHeaderTpl = `
+	{{- if .Settings.ShowHeader -}}
+		{{- with .Module.Header -}}
+			{{ sanitizeRst . }}
+			{{ printf "\n" }}
+		{{- end -}}
+	{{ end -}}
+	`
+
+	rstDocumentFooterTpl = `
+	{{- if .Settings.ShowFooter -}}
+		{{- with .Module.Footer -}}
+			{{ sanitizeRst . }}
+			{{ printf "\n" }}
+		{{- end -}}
+	{{ end -}}
+	`
+
+	rstDocumentRequirementsTpl = `
+	{{- if .Settings.ShowRequirements -}}
+		{{ heading 0 "Requirements" }}
+		{{ if not .Module.Requirements }}
+			No requirements.
+		{{ else }}
+			The following requirements are needed by this module:
+			{{- range .Module.Requirements }}
+				{{ $version := ternary (tostring .Version) (printf " (%s)" .Version) "" }}
+				{{ $source := link (tostring .Source) (tostring .URL) }}
+				- {{ .Name }}{{ $version }}{{ ternary $source (printf " from %s" $source) "" }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	rstDocumentProvidersTpl = `
+	{{- if .Settings.ShowProviders -}}
+		{{ heading 0 "Providers" }}
+		{{ if not .Module.Providers }}
+			No provider.
+		{{ else }}
+			The following providers are used by this module:
+			{{- range .Module.Providers }}
+				{{ $version := ternary (tostring .Version) (printf " (%s)" .Version) "" }}
+				{{ $source := link (tostring .Source) (tostring .URL) }}
+				- {{ .FullName }}{{ $version }}{{ ternary $source (printf " from %s" $source) "" }} ({{ .Kind }})
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	rstDocumentModulesTpl = `
+	{{- if .Settings.ShowModules -}}
+		{{ heading 0 "Modules" }}
+		{{ if not .Module.Modules }}
+			No module.
+		{{ else }}
+			The following modules are called by this module:
+			{{- range .Module.Modules }}
+
+				{{ heading 1 .Name }}
+
+				Source: {{ .Source | sanitizeRst }}
+
+				Version: {{ tostring .Version | default "n/a" }}
+			{{ end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	rstDocumentResourcesTpl = `
+	{{- if .Settings.ShowResources -}}
+		{{ heading 0 "Resources" }}
+		{{ if not .Module.Resources }}
+			No resource.
+		{{ else }}
+			The following resources are used by this module:
+			{{ range .Module.Resources }}
+				- {{ link .Address (tostring .URL) }} ({{ ternary .IsData "data source" "resource" }}, provider: {{ .Provider }}{{ with .Position.String }}, position: {{ . }}{{ end }})
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	rstDocumentTagsTpl = `
+	{{ with .Get "since" }}
+		Since: {{ sanitizeRst . }}
+	{{- end }}
+
+	{{ with .Get "group" }}
+		Group: {{ sanitizeRst . }}
+	{{- end }}
+
+	{{ with .Get "example" }}
+		Example: {{ type . }}
+	{{- end }}
+	`
+
+	rstDocumentInputsTpl = `
+	{{- if .Settings.ShowInputs -}}
+		{{- if .Settings.ShowRequired -}}
+			{{ heading 0 "Required Inputs" }}
+			{{ if not .Module.RequiredInputs }}
+				No required input.
+			{{ else }}
+				The following input variables are required:
+				{{- range .Module.RequiredInputs }}
+					{{ template "input" . }}
+				{{- end }}
+			{{- end }}
+			{{ heading 0 "Optional Inputs" }}
+			{{ if not .Module.OptionalInputs }}
+				No optional input.
+			{{ else }}
+				The following input variables are optional (have default values):
+				{{- range .Module.OptionalInputs }}
+					{{ template "input" . }}
+				{{- end }}
+			{{ end }}
+		{{ else -}}
+			{{ heading 0 "Inputs" }}
+			{{ if not .Module.Inputs }}
+				No input.
+			{{ else }}
+				The following input variables are supported:
+				{{- range .Module.Inputs }}
+					{{ template "input" . }}
+				{{- end }}
+			{{ end }}
+		{{- end }}
+	{{ end -}}
+	`
+
+	rstDocumentInputTpl = `
+	{{ printf "\n" }}
+	{{ heading 1 .Name }}
+
+	{{ if .Tags.Has "deprecated" }}
+		*Deprecated*{{ with .Tags.Get "deprecated" }}: {{ sanitizeRst . }}{{ end }}
+	{{- end }}
+
+	Description: {{ tostring .Description | sanitizeRst }}
+
+	Type: {{ tostring .Type | type }}
+
+	{{ if .HasAttributes }}
+		Attributes:
+
+		{{ listTable }}
+
+		{{ row "Name" }}
+		{{ cell "Description" }}
+		{{ cell "Type" }}
+		{{ cell "Default" }}
+		{{ cell "Required" }}
+		{{- range attributes .Structure }}
+			{{ row .Name }}
+			{{ cell (tostring .Description | sanitizeRst) }}
+			{{ cell (attributeType .Type) }}
+			{{ cell (attributeDefault .) }}
+			{{ cell (ternary .Optional "no" "yes") }}
+		{{- end }}
+	{{- end }}
+
+	{{ if or .HasDefault (not isRequired) }}
+		Default: {{ defaultValue . }}
+	{{- end }}
+
+	{{ if and .Sensitive showSensitivity }}
+		Sensitive: yes
+	{{- end }}
+
+	{{ if .HasValidations }}
+		Validation:
+		{{ range .Validations }}
+			- {{ condition .Condition }}: {{ tostring .ErrorMessage | sanitizeRst }}
+		{{- end }}
+	{{- end }}
+
+	{{ template "tags" .Tags }}
+	`
+
+	rstDocumentOutputsTpl = `
+	{{- if .Settings.ShowOutputs -}}
+		{{ heading 0 "Outputs" }}
+		{{ if not .Module.Outputs }}
+			No output.
+		{{ else }}
+			The following outputs are exported:
+			{{- range .Module.Outputs }}
+
+				{{ heading 1 .Name }}
+
+				{{ if .Tags.Has "deprecated" }}
+					*Deprecated*{{ with .Tags.Get "deprecated" }}: {{ sanitizeRst . }}{{ end }}
+				{{- end }}
+
+				Description: {{ tostring .Description | sanitizeRst }}
+
+				{{ if $.Settings.OutputValues }}
+					{{- $sensitive := ternary .Sensitive "<sensitive>" .GetValue -}}
+					Value: {{ value $sensitive }}
+
+					{{ if $.Settings.ShowSensitivity -}}
+						Sensitive: {{ ternary (.Sensitive) "yes" "no" }}
+					{{- end }}
+				{{ else if and $.Settings.ShowSensitivity .Sensitive }}
+					Sensitive: yes
+				{{ end }}
+
+				{{ template "tags" .Tags }}
+			{{ end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	rstDocumentLocalsTpl = `
+	{{- if .Settings.ShowLocals -}}
+		{{ heading 0 "Locals" }}
+		{{ if not .Module.Locals }}
+			No local.
+		{{ else }}
+			The following local values are declared:
+			{{- range .Module.Locals }}
+
+				{{ heading 1 .Name }}
+
+				Description: {{ tostring .Description | sanitizeRst }}
+
+				Value: {{ .Expression | type }}
+			{{ end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	rstDocumentUsageTpl = `
+	{{- if .Settings.ShowUsage -}}
+		{{ heading 0 "Usage" }}
+
+		{{ usage .Module }}
+		{{ printf "\n" }}
+	{{ end -}}
+	`
+
+	rstDocumentTpl = `
+	{{- template "header" . -}}
+	{{- template "usage" . -}}
+	{{- template "requirements" . -}}
+	{{- template "providers" . -}}
+	{{- template "modules" . -}}
+	{{- template "resources" . -}}
+	{{- template "inputs" . -}}
+	{{- template "outputs" . -}}
+	{{- template "locals" . -}}
+	{{- template "footer" . -}}
+	`
+)
+
+// RstDocument represents reStructuredText Document format.
+type RstDocument struct {
+	template *tmpl.Template
+}
+
+// NewRstDocument returns new instance of RstDocument.
+func NewRstDocument(settings *print.Settings) *RstDocument {
+	tt := tmpl.NewTemplate(&tmpl.Item{
+		Name: "document",
+		Text: rstDocumentTpl,
+	}, &tmpl.Item{
+		Name: "header",
+		Text: rstDocumentHeaderTpl,
+	}, &tmpl.Item{
+		Name: "footer",
+		Text: rstDocumentFooterTpl,
+	}, &tmpl.Item{
+		Name: "requirements",
+		Text: rstDocumentRequirementsTpl,
+	}, &tmpl.Item{
+		Name: "providers",
+		Text: rstDocumentProvidersTpl,
+	}, &tmpl.Item{
+		Name: "modules",
+		Text: rstDocumentModulesTpl,
+	}, &tmpl.Item{
+		Name: "resources",
+		Text: rstDocumentResourcesTpl,
+	}, &tmpl.Item{
+		Name: "inputs",
+		Text: rstDocumentInputsTpl,
+	}, &tmpl.Item{
+		Name: "input",
+		Text: rstDocumentInputTpl,
+	}, &tmpl.Item{
+		Name: "tags",
+		Text: rstDocumentTagsTpl,
+	}, &tmpl.Item{
+		Name: "outputs",
+		Text: rstDocumentOutputsTpl,
+	}, &tmpl.Item{
+		Name: "locals",
+		Text: rstDocumentLocalsTpl,
+	}, &tmpl.Item{
+		Name: "usage",
+		Text: rstDocumentUsageTpl,
+	})
+	tt.Settings(settings)
+	tt.CustomFunc(template.FuncMap{
+		"usage": func(m *tfconf.Module) string {
+			return fmt.Sprintf(".. code-block:: hcl\n\n%s", indentRst(printUsage(m, settings, true, true), 3))
+		},
+		"heading": func(extra int, title string) string {
+			return printRstHeading(title, extra, settings)
+		},
+		"listTable": func() string {
+			return ".. list-table::\n   :header-rows: 1"
+		},
+		"row": func(content string) string {
+			return printRstListTableCell(content, true)
+		},
+		"cell": func(content string) string {
+			return printRstListTableCell(content, false)
+		},
+		"link": func(text string, url string) string {
+			return printRstLink(text, url)
+		},
+		"type": func(t string) string {
+			result, extraline := printRstCodeBlock(t, "hcl")
+			if !extraline {
+				result += "\n"
+			}
+			return result
+		},
+		"value": func(v string) string {
+			if v == "n/a" {
+				return v
+			}
+			result, extraline := printRstCodeBlock(v, "json")
+			if !extraline {
+				result += "\n"
+			}
+			return result
+		},
+		"defaultValue": func(i *tfconf.Input) string {
+			value, language := inputDefault(i, settings)
+			if value == "" {
+				return "n/a"
+			}
+			result, extraline := printRstCodeBlock(value, language)
+			if !extraline {
+				result += "\n"
+			}
+			return result
+		},
+		"attributes": func(t *tfconf.Type) []*tfconf.TypeAttribute {
+			return flattenTypeAttributes(t)
+		},
+		"attributeType": func(t *tfconf.Type) string {
+			result, _ := printRstCodeBlock(t.String(), "")
+			return result
+		},
+		"attributeDefault": func(a *tfconf.TypeAttribute) string {
+			// single-tick block of Markdown is doubled into inline literal
+			if result := printAttributeDefault(a); result != "n/a" {
+				return "`" + result + "`"
+			}
+			return "n/a"
+		},
+		"condition": func(c string) string {
+			return "`" + printCondition(c) + "`"
+		},
+		"isRequired": func() bool {
+			return settings.ShowRequired
+		},
+		"showSensitivity": func() bool {
+			return settings.ShowSensitivity
+		},
+	})
+	return &RstDocument{
+		template: tt,
+	}
+}
+
+// Print prints a Terraform module as reStructuredText document.
+func (d *RstDocument) Print(module *tfconf.Module, settings *print.Settings) (string, error) {
+	rendered, err := d.template.Render(module)
+	if err != nil {
+		return "", err
+	}
+	return sanitize(rendered), nil
+}
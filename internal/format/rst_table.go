@@ -0,0 +1,331 @@
+package format
+
+import (
+	"fmt"
+	"text/template"
+
+	"github.com/terraform-docs/terraform-docs/pkg/print"
+	"github.com/terraform-docs/terraform-docs/pkg/tfconf"
+	"github.com/terraform-docs/terraform-docs/pkg/tmpl"
+)
+
+const (
+	rstTableHeaderTpl = `
+	{{- if .Settings.ShowHeader -}}
+		{{- with .Module.Header -}}
+			{{ sanitizeRst . }}
+			{{ printf "\n" }}
+		{{- end -}}
+	{{ end -}}
+	`
+
+	rstTableFooterTpl = `
+	{{- if .Settings.ShowFooter -}}
+		{{- with .Module.Footer -}}
+			{{ sanitizeRst . }}
+			{{ printf "\n" }}
+		{{- end -}}
+	{{ end -}}
+	`
+
+	rstTableRequirementsTpl = `
+	{{- if .Settings.ShowRequirements -}}
+		{{ heading 0 "Requirements" }}
+		{{ if not .Module.Requirements }}
+			No requirements.
+		{{ else }}
+			{{ listTable }}
+
+			{{ row "Name" }}
+			{{ cell "Source" }}
+			{{ cell "Version" }}
+			{{- range .Module.Requirements }}
+				{{ row .Name }}
+				{{ cell (link (tostring .Source) (tostring .URL)) }}
+				{{ cell (tostring .Version) }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	rstTableProvidersTpl = `
+	{{- if .Settings.ShowProviders -}}
+		{{ heading 0 "Providers" }}
+		{{ if not .Module.Providers }}
+			No provider.
+		{{ else }}
+			{{ listTable }}
+
+			{{ row "Name" }}
+			{{ cell "Source" }}
+			{{ cell "Version" }}
+			{{ cell "Kind" }}
+			{{- range .Module.Providers }}
+				{{ row .FullName }}
+				{{ cell (link (tostring .Source) (tostring .URL)) }}
+				{{ cell (tostring .Version) }}
+				{{ cell .Kind }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	rstTableModulesTpl = `
+	{{- if .Settings.ShowModules -}}
+		{{ heading 0 "Modules" }}
+		{{ if not .Module.Modules }}
+			No module.
+		{{ else }}
+			{{ listTable }}
+
+			{{ row "Name" }}
+			{{ cell "Source" }}
+			{{ cell "Version" }}
+			{{- range .Module.Modules }}
+				{{ row .Name }}
+				{{ cell (sanitizeRst .Source) }}
+				{{ cell (tostring .Version) }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	rstTableResourcesTpl = `
+	{{- if .Settings.ShowResources -}}
+		{{ heading 0 "Resources" }}
+		{{ if not .Module.Resources }}
+			No resource.
+		{{ else }}
+			{{ listTable }}
+
+			{{ row "Name" }}
+			{{ cell "Type" }}
+			{{ cell "Provider" }}
+			{{ cell "Position" }}
+			{{- range .Module.Resources }}
+				{{ row (link .Address (tostring .URL)) }}
+				{{ cell .Type }}
+				{{ cell .Provider }}
+				{{ cell .Position.String }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	rstTableDescriptionTpl = `
+	{{- $description := tostring .Description | sanitizeRst -}}
+	{{- if .Tags.Has "deprecated" -}}
+		{{- $deprecated := "*Deprecated*" -}}
+		{{- with .Tags.Get "deprecated" }}{{ $deprecated = printf "%s: %s" $deprecated (sanitizeRst .) }}{{ end -}}
+		{{- $description = ternary (tostring .Description) (printf "%s\n\n%s" $deprecated $description) $deprecated -}}
+	{{- end -}}
+	{{ cell $description -}}
+	`
+
+	rstTableInputsTpl = `
+	{{- if .Settings.ShowInputs -}}
+		{{ heading 0 "Inputs" }}
+		{{ if not .Module.Inputs }}
+			No input.
+		{{ else }}
+			{{- $sensitivity := and .Settings.ShowSensitivity .Module.HasSensitiveInputs }}
+			{{ listTable }}
+
+			{{ row "Name" }}
+			{{ cell "Description" }}
+			{{ cell "Type" }}
+			{{ cell "Default" }}
+			{{- if .Settings.ShowRequired }}
+				{{ cell "Required" }}
+			{{- end }}
+			{{- if $sensitivity }}
+				{{ cell "Sensitive" }}
+			{{- end }}
+			{{- range .Module.Inputs }}
+				{{ row .Name }}
+				{{ template "description" . }}
+				{{ cell (tostring .Type | type) }}
+				{{ cell (defaultValue .) }}
+				{{- if $.Settings.ShowRequired }}
+					{{ cell (ternary .Required "yes" "no") }}
+				{{- end }}
+				{{- if $sensitivity }}
+					{{ cell (ternary .Sensitive "yes" "no") }}
+				{{- end }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	rstTableOutputsTpl = `
+	{{- if .Settings.ShowOutputs -}}
+		{{ heading 0 "Outputs" }}
+		{{ if not .Module.Outputs }}
+			No output.
+		{{ else }}
+			{{- $sensitivity := and .Settings.ShowSensitivity (or .Settings.OutputValues .Module.HasSensitiveOutputs) }}
+			{{ listTable }}
+
+			{{ row "Name" }}
+			{{ cell "Description" }}
+			{{- if .Settings.OutputValues }}
+				{{ cell "Value" }}
+			{{- end }}
+			{{- if $sensitivity }}
+				{{ cell "Sensitive" }}
+			{{- end }}
+			{{- range .Module.Outputs }}
+				{{ row .Name }}
+				{{ template "description" . }}
+				{{- if $.Settings.OutputValues }}
+					{{- $sensitive := ternary .Sensitive "<sensitive>" .GetValue }}
+					{{ cell (value $sensitive) }}
+				{{- end }}
+				{{- if $sensitivity }}
+					{{ cell (ternary .Sensitive "yes" "no") }}
+				{{- end }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	rstTableLocalsTpl = `
+	{{- if .Settings.ShowLocals -}}
+		{{ heading 0 "Locals" }}
+		{{ if not .Module.Locals }}
+			No local.
+		{{ else }}
+			{{ listTable }}
+
+			{{ row "Name" }}
+			{{ cell "Description" }}
+			{{ cell "Value" }}
+			{{- range .Module.Locals }}
+				{{ row .Name }}
+				{{ cell (tostring .Description | sanitizeRst) }}
+				{{ cell (type .Expression) }}
+			{{- end }}
+		{{ end }}
+	{{ end -}}
+	`
+
+	rstTableUsageTpl = `
+	{{- if .Settings.ShowUsage -}}
+		{{ heading 0 "Usage" }}
+
+		{{ usage .Module }}
+		{{ printf "\n" }}
+	{{ end -}}
+	`
+
+	rstTableTpl = `
+	{{- template "header" . -}}
+	{{- template "usage" . -}}
+	{{- template "requirements" . -}}
+	{{- template "providers" . -}}
+	{{- template "modules" . -}}
+	{{- template "resources" . -}}
+	{{- template "inputs" . -}}
+	{{- template "outputs" . -}}
+	{{- template "locals" . -}}
+	{{- template "footer" . -}}
+	`
+)
+
+// RstTable represents reStructuredText Table format.
+type RstTable struct {
+	template *tmpl.Template
+}
+
+// NewRstTable returns new instance of RstTable.
+func NewRstTable(settings *print.Settings) *RstTable {
+	tt := tmpl.NewTemplate(&tmpl.Item{
+		Name: "table",
+		Text: rstTableTpl,
+	}, &tmpl.Item{
+		Name: "header",
+		Text: rstTableHeaderTpl,
+	}, &tmpl.Item{
+		Name: "footer",
+		Text: rstTableFooterTpl,
+	}, &tmpl.Item{
+		Name: "requirements",
+		Text: rstTableRequirementsTpl,
+	}, &tmpl.Item{
+		Name: "providers",
+		Text: rstTableProvidersTpl,
+	}, &tmpl.Item{
+		Name: "modules",
+		Text: rstTableModulesTpl,
+	}, &tmpl.Item{
+		Name: "resources",
+		Text: rstTableResourcesTpl,
+	}, &tmpl.Item{
+		Name: "description",
+		Text: rstTableDescriptionTpl,
+	}, &tmpl.Item{
+		Name: "inputs",
+		Text: rstTableInputsTpl,
+	}, &tmpl.Item{
+		Name: "outputs",
+		Text: rstTableOutputsTpl,
+	}, &tmpl.Item{
+		Name: "locals",
+		Text: rstTableLocalsTpl,
+	}, &tmpl.Item{
+		Name: "usage",
+		Text: rstTableUsageTpl,
+	})
+	tt.Settings(settings)
+	tt.CustomFunc(template.FuncMap{
+		"usage": func(m *tfconf.Module) string {
+			return fmt.Sprintf(".. code-block:: hcl\n\n%s", indentRst(printUsage(m, settings, true, true), 3))
+		},
+		"heading": func(extra int, title string) string {
+			return printRstHeading(title, extra, settings)
+		},
+		"listTable": func() string {
+			return ".. list-table::\n   :header-rows: 1"
+		},
+		"row": func(content string) string {
+			return printRstListTableCell(content, true)
+		},
+		"cell": func(content string) string {
+			return printRstListTableCell(content, false)
+		},
+		"link": func(text string, url string) string {
+			return printRstLink(text, url)
+		},
+		"type": func(t string) string {
+			result, _ := printRstCodeBlock(t, "hcl")
+			return result
+		},
+		"value": func(v string) string {
+			var result = "n/a"
+			if v != "" {
+				result, _ = printRstCodeBlock(v, "json")
+			}
+			return result
+		},
+		"defaultValue": func(i *tfconf.Input) string {
+			value, language := inputDefault(i, settings)
+			if value == "" {
+				return "n/a"
+			}
+			result, _ := printRstCodeBlock(value, language)
+			return result
+		},
+	})
+	return &RstTable{
+		template: tt,
+	}
+}
+
+// Print prints a Terraform module as reStructuredText tables.
+func (t *RstTable) Print(module *tfconf.Module, settings *print.Settings) (string, error) {
+	rendered, err := t.template.Render(module)
+	if err != nil {
+		return "", err
+	}
+	return sanitize(rendered), nil
+}
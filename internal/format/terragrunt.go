@@ -0,0 +1,76 @@
+package format
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+
+	"github.com/terraform-docs/terraform-docs/pkg/print"
+	"github.com/terraform-docs/terraform-docs/pkg/tfconf"
+)
+
+// Terragrunt represents Terragrunt configuration format.
+type Terragrunt struct{}
+
+// NewTerragrunt returns new instance of Terragrunt.
+func NewTerragrunt(settings *print.Settings) *Terragrunt {
+	return &Terragrunt{}
+}
+
+// Print prints a Terraform module as Terragrunt configuration, i.e. a terraform
+// block with the source of the module and the map of its inputs. For example:
+//
+//	terraform {
+//	  source = "git::https://example.com/modules.git//vpc?ref=v1.0.0"
+//	}
+//
+//	inputs = {
+//	  # Name of the VPC.
+//	  name = ""
+//
+//	  cidr = "10.0.0.0/16"
+//	}
+//
+// Required inputs are printed first with a placeholder value based on their type,
+// followed by optional ones with their default value. Descriptions are printed as comments.
+func (t *Terragrunt) Print(module *tfconf.Module, settings *print.Settings) (string, error) {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "terraform {\nsource = %s\n}\n\n", tfconf.HCLString(settings.ModuleSource))
+
+	b.WriteString("inputs = {\n")
+	for i, inputs := range [][]*tfconf.Input{module.RequiredInputs, module.OptionalInputs} {
+		if i > 0 && len(module.RequiredInputs) > 0 && len(inputs) > 0 {
+			b.WriteString("\n")
+		}
+		commented := false
+		for j, input := range inputs {
+			comment := hclComment(string(input.Description))
+			// inputs with comment are separated from the others by a blank
+			// line, to keep the rest of them aligned
+			if j > 0 && (comment != "" || commented) {
+				b.WriteString("\n")
+			}
+			commented = comment != ""
+			b.WriteString(comment)
+			fmt.Fprintf(&b, "%s = %s\n", input.Name, terragruntValue(input, settings))
+		}
+	}
+	b.WriteString("}\n")
+
+	formatted := hclwrite.Format([]byte(b.String()))
+	return strings.TrimSuffix(string(formatted), "\n"), nil
+}
+
+// terragruntValue returns the HCL representation of the default value of 'input',
+// or a placeholder value of its type if it's required.
+func terragruntValue(input *tfconf.Input, settings *print.Settings) string {
+	if input.Required {
+		return hclPlaceholder(input.Structure)
+	}
+	if settings.DefaultSource && input.DefaultSource != "" {
+		return input.GetSource()
+	}
+	return input.GetHCL()
+}
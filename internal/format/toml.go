@@ -21,7 +21,10 @@ func NewTOML(settings *print.Settings) *TOML {
 func (t *TOML) Print(module *tfconf.Module, settings *print.Settings) (string, error) {
 	copy := tfconf.Module{
 		Header:       "",
+		Footer:       "",
 		Providers:    make([]*tfconf.Provider, 0),
+		Modules:      make([]*tfconf.ModuleCall, 0),
+		Resources:    make([]*tfconf.Resource, 0),
 		Inputs:       make([]*tfconf.Input, 0),
 		Outputs:      make([]*tfconf.Output, 0),
 		Requirements: make([]*tfconf.Requirement, 0),
@@ -36,12 +39,24 @@ func (t *TOML) Print(module *tfconf.Module, settings *print.Settings) (string, e
 	if settings.ShowOutputs {
 		copy.Outputs = module.Outputs
 	}
+	if settings.ShowLocals {
+		copy.Locals = module.Locals
+	}
 	if settings.ShowProviders {
 		copy.Providers = module.Providers
 	}
+	if settings.ShowModules {
+		copy.Modules = module.Modules
+	}
+	if settings.ShowResources {
+		copy.Resources = module.Resources
+	}
 	if settings.ShowRequirements {
 		copy.Requirements = module.Requirements
 	}
+	if settings.ShowFooter {
+		copy.Footer = module.Footer
+	}
 
 	buffer := new(bytes.Buffer)
 	encoder := toml.NewEncoder(buffer)
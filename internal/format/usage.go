@@ -0,0 +1,117 @@
+package format
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+
+	"github.com/terraform-docs/terraform-docs/pkg/print"
+	"github.com/terraform-docs/terraform-docs/pkg/tfconf"
+)
+
+// usageModuleName is the label of the module block printed by Usage, which is
+// also used to reference the outputs of the module.
+const usageModuleName = "this"
+
+// Usage represents example usage of the module format.
+type Usage struct{}
+
+// NewUsage returns new instance of Usage.
+func NewUsage(settings *print.Settings) *Usage {
+	return &Usage{}
+}
+
+// Print prints a Terraform module as an example of calling it in HCL, i.e. a
+// module block with its source, version and inputs. For example:
+//
+//	module "this" {
+//	  source  = "terraform-aws-modules/vpc/aws"
+//	  version = "3.0.0"
+//
+//	  # Name of the VPC.
+//	  name = ""
+//
+//	  # cidr = "10.0.0.0/16"
+//	}
+//
+//	# Outputs:
+//	#   module.this.vpc_id - The ID of the VPC.
+//
+// Required inputs are printed with a placeholder value based on their type,
+// followed by optional ones commented out with their default value. The local
+// path of the module is printed as its source, unless it's set in settings.
+func (u *Usage) Print(module *tfconf.Module, settings *print.Settings) (string, error) {
+	return printUsage(module, settings, settings.ShowInputs, settings.ShowOutputs), nil
+}
+
+// printUsage returns the module block calling 'module' in HCL, with its inputs
+// and the list of its outputs if 'inputs' and 'outputs' are set respectively.
+func printUsage(module *tfconf.Module, settings *print.Settings, inputs bool, outputs bool) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "module %q {\n", usageModuleName)
+	fmt.Fprintf(&b, "source = %s\n", tfconf.HCLString(usageSource(module, settings)))
+	if settings.ModuleVersion != "" {
+		fmt.Fprintf(&b, "version = %s\n", tfconf.HCLString(settings.ModuleVersion))
+	}
+
+	if inputs {
+		for _, input := range module.RequiredInputs {
+			b.WriteString("\n")
+			b.WriteString(hclComment(string(input.Description)))
+			fmt.Fprintf(&b, "%s = %s\n", input.Name, hclPlaceholder(input.Structure))
+		}
+		for _, input := range module.OptionalInputs {
+			b.WriteString("\n")
+			b.WriteString(hclComment(string(input.Description)))
+			b.WriteString(hclComment(fmt.Sprintf("%s = %s", input.Name, usageDefault(input, settings))))
+		}
+	}
+	b.WriteString("}\n")
+
+	if outputs && len(module.Outputs) > 0 {
+		b.WriteString("\n# Outputs:\n")
+		for _, output := range module.Outputs {
+			reference := fmt.Sprintf("module.%s.%s", usageModuleName, output.Name)
+			description := strings.Join(strings.Fields(string(output.Description)), " ")
+			if description != "" {
+				reference = fmt.Sprintf("%s - %s", reference, description)
+			}
+			fmt.Fprintf(&b, "#   %s\n", reference)
+		}
+	}
+
+	formatted := hclwrite.Format([]byte(b.String()))
+	return strings.TrimSuffix(string(formatted), "\n")
+}
+
+// usageSource returns the source of the module to call, or if it isn't set, the
+// local path of the module as a placeholder (e.g. "./modules/vpc"), as provided
+// to load the module. The name of the module directory is used instead if it's
+// the current directory itself.
+func usageSource(module *tfconf.Module, settings *print.Settings) string {
+	if settings.ModuleSource != "" {
+		return settings.ModuleSource
+	}
+	dir := filepath.Clean(module.Path)
+	if dir == "." {
+		if abs, err := filepath.Abs(dir); err == nil {
+			dir = filepath.Base(abs)
+		}
+	}
+	source := filepath.ToSlash(dir)
+	if source == ".." || strings.HasPrefix(source, "../") || filepath.IsAbs(dir) {
+		return source
+	}
+	return "./" + source
+}
+
+// usageDefault returns the HCL representation of the default value of 'input'.
+func usageDefault(input *tfconf.Input, settings *print.Settings) string {
+	if settings.DefaultSource && input.DefaultSource != "" {
+		return input.GetSource()
+	}
+	return input.GetHCL()
+}
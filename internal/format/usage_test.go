@@ -0,0 +1,155 @@
+package format
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/module"
+	"github.com/terraform-docs/terraform-docs/internal/testutil"
+	"github.com/terraform-docs/terraform-docs/pkg/print"
+	"github.com/terraform-docs/terraform-docs/pkg/tfconf"
+)
+
+func TestUsage(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ModuleSource:  "terraform-docs/foo/bar",
+		ModuleVersion: "1.2.3",
+	}).Build()
+
+	expected, err := testutil.GetExpected("usage", "usage")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewUsage(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestUsageSortByName(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ModuleSource:  "terraform-docs/foo/bar",
+		ModuleVersion: "1.2.3",
+		SortByName:    true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("usage", "usage-SortByName")
+	assert.Nil(err)
+
+	options, err := module.NewOptions().With(&module.Options{
+		SortBy: &module.SortBy{
+			Name: true,
+		},
+	})
+	assert.Nil(err)
+
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewUsage(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestUsageDefaultSource(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().WithSections().With(&print.Settings{
+		ModuleSource:  "../modules/baz",
+		DefaultSource: true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("usage", "usage-DefaultSource")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewUsage(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestUsageOnlyInputs(t *testing.T) {
+	assert := assert.New(t)
+	settings := testutil.Settings().With(&print.Settings{
+		ModuleSource: "terraform-docs/foo/bar",
+		ShowInputs:   true,
+	}).Build()
+
+	expected, err := testutil.GetExpected("usage", "usage-OnlyInputs")
+	assert.Nil(err)
+
+	options := module.NewOptions()
+	module, err := testutil.GetModule(options)
+	assert.Nil(err)
+
+	printer := NewUsage(settings)
+	actual, err := printer.Print(module, settings)
+
+	assert.Nil(err)
+	assert.Equal(expected, actual)
+}
+
+func TestUsageSource(t *testing.T) {
+	wd, err := os.Getwd()
+	assert.Nil(t, err)
+
+	tests := []struct {
+		name     string
+		path     string
+		source   string
+		expected string
+	}{
+		{
+			name:     "module source from settings",
+			path:     "modules/vpc",
+			source:   "terraform-aws-modules/vpc/aws",
+			expected: "terraform-aws-modules/vpc/aws",
+		},
+		{
+			name:     "module source of relative path",
+			path:     "modules/vpc",
+			expected: "./modules/vpc",
+		},
+		{
+			name:     "module source of relative path to parent",
+			path:     "../vpc/",
+			expected: "../vpc",
+		},
+		{
+			name:     "module source of absolute path",
+			path:     filepath.Join(wd, "modules", "vpc"),
+			expected: filepath.ToSlash(filepath.Join(wd, "modules", "vpc")),
+		},
+		{
+			name:     "module source of current directory",
+			path:     ".",
+			expected: "./" + filepath.Base(wd),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			module := &tfconf.Module{Path: tt.path}
+			settings := &print.Settings{ModuleSource: tt.source}
+
+			actual := usageSource(module, settings)
+
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
@@ -4,6 +4,10 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+	"unicode/utf8"
+
+	"github.com/terraform-docs/terraform-docs/pkg/print"
+	"github.com/terraform-docs/terraform-docs/pkg/tfconf"
 )
 
 // sanitize cleans a Markdown document to soothe linters.
@@ -53,3 +57,191 @@ func printFencedAsciidocCodeBlock(code string, language string) (string, bool) {
 	}
 	return fmt.Sprintf("`%s`", code), false
 }
+
+// printRstCodeBlock prints codes in 'code-block' directive, it automatically detects
+// if the input 'code' contains '\n' it will use the directive, otherwise it wraps
+// the 'code' inside inline literal.
+// If the code is multi-line it also appends an extra '\n` at the end and returns
+// true accordingly, otherwise returns false for non-carriage return.
+func printRstCodeBlock(code string, language string) (string, bool) {
+	if strings.Contains(code, "\n") {
+		return fmt.Sprintf("\n\n.. code-block:: %s\n\n%s\n", language, indentRst(code, 3)), true
+	}
+	return fmt.Sprintf("``%s``", code), false
+}
+
+// rstHeadingChars are the characters the headings are underlined with in
+// reStructuredText, from the top level to the bottom one.
+var rstHeadingChars = []string{"=", "-", "~", "^", "\"", "'"}
+
+// printRstHeading underlines 'title' with the character of its level, which is
+// the base level of provided 'settings.IndentLevel' plus any extra level needed
+// for subsection (e.g. 'Required Inputs' which is a subsection of 'Inputs').
+func printRstHeading(title string, extra int, settings *print.Settings) string {
+	var base = settings.IndentLevel
+	if base < 1 || base > 5 {
+		base = 2
+	}
+	level := base - 1 + extra
+	if level >= len(rstHeadingChars) {
+		level = len(rstHeadingChars) - 1
+	}
+	return fmt.Sprintf("%s\n%s", title, strings.Repeat(rstHeadingChars[level], utf8.RuneCountInString(title)))
+}
+
+// printRstListTableCell prints 'content' as a cell of reStructuredText 'list-table'
+// directive. The first cell of each row starts the row too. Multi-line content is
+// indented under the bullet of the cell.
+func printRstListTableCell(content string, first bool) string {
+	bullet := "     - "
+	if first {
+		bullet = "   * - "
+	}
+	content = strings.Trim(content, "\r\n")
+	if content == "" {
+		content = "n/a"
+	}
+	lines := strings.SplitN(content, "\n", 2)
+	if len(lines) == 1 {
+		return bullet + content
+	}
+	return bullet + lines[0] + "\n" + indentRst(lines[1], len(bullet))
+}
+
+// printRstLink prints 'text' as an anonymous reStructuredText hyperlink to 'url',
+// or as is if 'url' is empty.
+func printRstLink(text string, url string) string {
+	if url == "" {
+		return text
+	}
+	return fmt.Sprintf("`%s <%s>`__", text, url)
+}
+
+// indentRst indents all the non-empty lines of 's' with 'width' spaces.
+func indentRst(s string, width int) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) != "" {
+			lines[i] = strings.Repeat(" ", width) + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// inputDefault returns the default value of 'input' and the language it's written
+// in, i.e. its raw HCL source or its canonical HCL representation, based on the
+// settings, or its JSON representation otherwise.
+func inputDefault(input *tfconf.Input, settings *print.Settings) (string, string) {
+	switch {
+	case settings.DefaultSource && input.DefaultSource != "":
+		return input.GetSource(), "hcl"
+	case settings.DefaultHCL:
+		return input.GetHCL(), "hcl"
+	}
+	return input.GetValue(), "json"
+}
+
+// foldedLinesPattern matches line breaks, along with the indentation around
+// them, to fold multi line expressions into one line.
+var foldedLinesPattern = regexp.MustCompile(`\s*\n\s*`)
+
+// printCondition prints the raw source of a validation condition inside
+// single-tick block. Multi line conditions are folded into one line.
+func printCondition(condition string) string {
+	condition = foldedLinesPattern.ReplaceAllString(strings.TrimSpace(condition), " ")
+	return fmt.Sprintf("`%s`", condition)
+}
+
+// flattenTypeAttributes returns the attributes of an object type (or a
+// collection of object type) and all of their nested attributes, in order of
+// declaration. Nested attributes are named after their parents, e.g. 'rule.port'.
+func flattenTypeAttributes(t *tfconf.Type) []*tfconf.TypeAttribute {
+	attributes := []*tfconf.TypeAttribute{}
+	var walk func(t *tfconf.Type, prefix string)
+	walk = func(t *tfconf.Type, prefix string) {
+		for _, attribute := range t.ObjectAttributes() {
+			flat := *attribute
+			flat.Name = prefix + attribute.Name
+			attributes = append(attributes, &flat)
+			walk(attribute.Type, flat.Name+".")
+		}
+	}
+	walk(t, "")
+	return attributes
+}
+
+// printAttributeDefault prints the default value of an attribute of object type
+// inside single-tick block. Multi line values are folded into one line.
+func printAttributeDefault(attribute *tfconf.TypeAttribute) string {
+	if !attribute.Optional {
+		return "n/a"
+	}
+	if attribute.Default == "" {
+		return "`null`"
+	}
+	value := foldedLinesPattern.ReplaceAllString(strings.TrimSpace(attribute.Default), " ")
+	return fmt.Sprintf("`%s`", value)
+}
+
+// hclComment prints each line of 's' as a single line HCL comment, or returns
+// empty if 's' is empty.
+func hclComment(s string) string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return ""
+	}
+	var b strings.Builder
+	for _, line := range strings.Split(s, "\n") {
+		line = strings.TrimRight(line, " \t\r")
+		if line == "" {
+			b.WriteString("#\n")
+			continue
+		}
+		fmt.Fprintf(&b, "# %s\n", line)
+	}
+	return b.String()
+}
+
+// hclPlaceholder returns a placeholder value of Terraform type 't' in HCL, i.e.
+// the zero value of primitive types and an empty collection. Objects are printed
+// with placeholders of their required attributes, and '""' is returned for 'any'
+// type (or if the type is unknown). For example:
+//
+//	string                                      ""
+//	list(string)                                []
+//	tuple([string, number])                     ["", 0]
+//	object({ a = string, b = optional(bool) })  { a = "" }
+func hclPlaceholder(t *tfconf.Type) string {
+	if t == nil {
+		return `""`
+	}
+	switch t.Kind {
+	case "number":
+		return "0"
+	case "bool":
+		return "false"
+	case "list", "set":
+		return "[]"
+	case "map":
+		return "{}"
+	case "tuple":
+		elements := make([]string, len(t.Elements))
+		for i, element := range t.Elements {
+			elements[i] = hclPlaceholder(element)
+		}
+		return "[" + strings.Join(elements, ", ") + "]"
+	case "object":
+		var b strings.Builder
+		for _, attribute := range t.Attributes {
+			if attribute.Optional {
+				continue
+			}
+			fmt.Fprintf(&b, "%s = %s\n", attribute.Name, hclPlaceholder(attribute.Type))
+		}
+		if b.Len() == 0 {
+			return "{}"
+		}
+		return "{\n" + b.String() + "}"
+	}
+	return `""`
+}
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/pkg/tfconf"
 )
 
 func TestSanitizeMarkdown(t *testing.T) {
@@ -119,3 +121,198 @@ func TestFenceCodeBlock(t *testing.T) {
 		})
 	}
 }
+
+func TestPrintCondition(t *testing.T) {
+	tests := []struct {
+		name      string
+		condition string
+		expected  string
+	}{
+		{
+			name:      "single line",
+			condition: "var.foo > 0",
+			expected:  "`var.foo > 0`",
+		},
+		{
+			name:      "multi lines",
+			condition: "contains(\n      [\"foo\", \"bar\"],\n      var.foo,\n    )",
+			expected:  "`contains( [\"foo\", \"bar\"], var.foo, )`",
+		},
+		{
+			name:      "surrounding spaces",
+			condition: "\n  var.foo != \"\"\n",
+			expected:  "`var.foo != \"\"`",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := printCondition(tt.condition)
+
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestFlattenTypeAttributes(t *testing.T) {
+	object := &tfconf.Type{
+		Kind: "object",
+		Attributes: []*tfconf.TypeAttribute{
+			{Name: "name", Type: &tfconf.Type{Kind: "string"}},
+			{Name: "rule", Type: &tfconf.Type{
+				Kind: "list",
+				Element: &tfconf.Type{
+					Kind: "object",
+					Attributes: []*tfconf.TypeAttribute{
+						{Name: "port", Type: &tfconf.Type{Kind: "number"}},
+					},
+				},
+			}},
+		},
+	}
+	tests := []struct {
+		name     string
+		typ      *tfconf.Type
+		expected []string
+	}{
+		{
+			name:     "primitive",
+			typ:      &tfconf.Type{Kind: "string"},
+			expected: []string{},
+		},
+		{
+			name:     "object",
+			typ:      object,
+			expected: []string{"name", "rule", "rule.port"},
+		},
+		{
+			name:     "map of object",
+			typ:      &tfconf.Type{Kind: "map", Element: object},
+			expected: []string{"name", "rule", "rule.port"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := []string{}
+			for _, attribute := range flattenTypeAttributes(tt.typ) {
+				actual = append(actual, attribute.Name)
+			}
+
+			assert.Equal(tt.expected, actual)
+			assert.Equal("port", object.Attributes[1].Type.Element.Attributes[0].Name)
+		})
+	}
+}
+
+func TestPrintAttributeDefault(t *testing.T) {
+	tests := []struct {
+		name      string
+		attribute *tfconf.TypeAttribute
+		expected  string
+	}{
+		{
+			name:      "required",
+			attribute: &tfconf.TypeAttribute{Name: "foo"},
+			expected:  "n/a",
+		},
+		{
+			name:      "optional without default",
+			attribute: &tfconf.TypeAttribute{Name: "foo", Optional: true},
+			expected:  "`null`",
+		},
+		{
+			name:      "optional with default",
+			attribute: &tfconf.TypeAttribute{Name: "foo", Optional: true, Default: "[\n  \"tcp\",\n  \"udp\",\n]"},
+			expected:  "`[ \"tcp\", \"udp\", ]`",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := printAttributeDefault(tt.attribute)
+
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
+func TestHCLPlaceholder(t *testing.T) {
+	tests := []struct {
+		name     string
+		typ      *tfconf.Type
+		expected string
+	}{
+		{
+			name:     "placeholder of unknown type",
+			typ:      nil,
+			expected: `""`,
+		},
+		{
+			name:     "placeholder of any",
+			typ:      &tfconf.Type{Kind: "any"},
+			expected: `""`,
+		},
+		{
+			name:     "placeholder of string",
+			typ:      &tfconf.Type{Kind: "string"},
+			expected: `""`,
+		},
+		{
+			name:     "placeholder of number",
+			typ:      &tfconf.Type{Kind: "number"},
+			expected: "0",
+		},
+		{
+			name:     "placeholder of bool",
+			typ:      &tfconf.Type{Kind: "bool"},
+			expected: "false",
+		},
+		{
+			name:     "placeholder of list",
+			typ:      &tfconf.Type{Kind: "list", Element: &tfconf.Type{Kind: "string"}},
+			expected: "[]",
+		},
+		{
+			name:     "placeholder of set",
+			typ:      &tfconf.Type{Kind: "set", Element: &tfconf.Type{Kind: "number"}},
+			expected: "[]",
+		},
+		{
+			name:     "placeholder of map",
+			typ:      &tfconf.Type{Kind: "map", Element: &tfconf.Type{Kind: "string"}},
+			expected: "{}",
+		},
+		{
+			name: "placeholder of tuple",
+			typ: &tfconf.Type{Kind: "tuple", Elements: []*tfconf.Type{
+				{Kind: "string"},
+				{Kind: "number"},
+			}},
+			expected: `["", 0]`,
+		},
+		{
+			name: "placeholder of object",
+			typ: &tfconf.Type{Kind: "object", Attributes: []*tfconf.TypeAttribute{
+				{Name: "a", Type: &tfconf.Type{Kind: "string"}},
+				{Name: "b", Type: &tfconf.Type{Kind: "bool"}, Optional: true},
+				{Name: "c", Type: &tfconf.Type{Kind: "list", Element: &tfconf.Type{Kind: "number"}}},
+			}},
+			expected: "{\na = \"\"\nc = []\n}",
+		},
+		{
+			name: "placeholder of object with optional attributes",
+			typ: &tfconf.Type{Kind: "object", Attributes: []*tfconf.TypeAttribute{
+				{Name: "a", Type: &tfconf.Type{Kind: "string"}, Optional: true},
+			}},
+			expected: "{}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := hclPlaceholder(tt.typ)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
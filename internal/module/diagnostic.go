@@ -0,0 +1,118 @@
+package module
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/terraform-docs/terraform-docs/internal/tfconfig"
+)
+
+// printDiagnostics writes the diagnostics of loading the module to 'w'. Only
+// warnings are printed by default, as errors are returned to the caller anyway,
+// each on a single line. For example:
+//
+//	main.tf:5:13: warning: Module loaded with legacy HCL parser
+//
+// If 'verbose' is set all the diagnostics are printed, along with their detail
+// and the snippet of the source they are pointing at.
+func printDiagnostics(w io.Writer, diags tfconfig.Diagnostics, verbose bool) {
+	// diagnostics are written at once, not to get mixed up with the ones of
+	// other modules being loaded concurrently.
+	var b strings.Builder
+	for _, diag := range diags {
+		if diag.Severity != tfconfig.DiagWarning && !verbose {
+			continue
+		}
+		fmt.Fprintln(&b, diagnosticHeader(diag))
+		if !verbose {
+			continue
+		}
+		if snippet := diagnosticSnippet(diag.Pos); snippet != "" {
+			fmt.Fprintf(&b, "\n%s\n", snippet)
+		}
+		if diag.Detail != "" {
+			fmt.Fprintf(&b, "\n  %s\n", diag.Detail)
+		}
+		fmt.Fprintln(&b)
+	}
+	if b.Len() > 0 {
+		io.WriteString(w, b.String()) //nolint:errcheck
+	}
+}
+
+// diagnosticHeader returns the 'file:line:column: severity: summary' line of
+// the diagnostic. The location is omitted if the diagnostic doesn't have one.
+func diagnosticHeader(diag tfconfig.Diagnostic) string {
+	severity := "error"
+	if diag.Severity == tfconfig.DiagWarning {
+		severity = "warning"
+	}
+	header := fmt.Sprintf("%s: %s", severity, diag.Summary)
+	if diag.Pos == nil || diag.Pos.Filename == "" {
+		return header
+	}
+	location := fmt.Sprintf("%s:%d", diag.Pos.Filename, diag.Pos.Line)
+	if diag.Pos.Column > 0 {
+		location = fmt.Sprintf("%s:%d", location, diag.Pos.Column)
+	}
+	return fmt.Sprintf("%s: %s", location, header)
+}
+
+// diagnosticSnippet returns the source line 'pos' is pointing at, with a caret
+// under its column if known. For example:
+//
+//	5 | variable "foo" bar
+//	  |             ^
+//
+// It returns empty if the source file can't be read.
+func diagnosticSnippet(pos *tfconfig.SourcePos) string {
+	if pos == nil || pos.Filename == "" || pos.Line < 1 {
+		return ""
+	}
+	line, ok := readLine(pos.Filename, pos.Line)
+	if !ok {
+		return ""
+	}
+	line = strings.TrimRight(line, "\r")
+
+	number := fmt.Sprintf("%d", pos.Line)
+	gutter := strings.Repeat(" ", len(number))
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "  %s | %s", number, line)
+	if pos.Column > 0 {
+		// keep tabs of the line, so the caret lines up with the column
+		var indent strings.Builder
+		for i, r := range []rune(line) {
+			if i >= pos.Column-1 {
+				break
+			}
+			if r == '\t' {
+				indent.WriteRune('\t')
+			} else {
+				indent.WriteRune(' ')
+			}
+		}
+		fmt.Fprintf(&b, "\n  %s | %s^", gutter, indent.String())
+	}
+	return b.String()
+}
+
+func readLine(filename string, number int) (string, bool) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return "", false
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for n := 1; scanner.Scan(); n++ {
+		if n == number {
+			return scanner.Text(), true
+		}
+	}
+	return "", false
+}
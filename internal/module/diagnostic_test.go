@@ -0,0 +1,70 @@
+package module
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/tfconfig"
+)
+
+func TestPrintDiagnostics(t *testing.T) {
+	filename := filepath.Join("testdata", "legacy-module", "variables.tf")
+	diags := tfconfig.Diagnostics{
+		{
+			Severity: tfconfig.DiagError,
+			Summary:  "Invalid block definition",
+			Detail:   "A block definition must have block content delimited by \"{\" and \"}\".",
+			Pos:      &tfconfig.SourcePos{Filename: filename, Line: 1, Column: 20},
+		},
+		{
+			Severity: tfconfig.DiagWarning,
+			Summary:  "Module loaded with legacy HCL parser",
+			Pos:      &tfconfig.SourcePos{Filename: filename, Line: 3},
+		},
+		{
+			Severity: tfconfig.DiagWarning,
+			Summary:  "Something is not right",
+		},
+	}
+	tests := []struct {
+		name     string
+		verbose  bool
+		expected string
+	}{
+		{
+			name:    "print warnings",
+			verbose: false,
+			expected: filename + ":3: warning: Module loaded with legacy HCL parser\n" +
+				"warning: Something is not right\n",
+		},
+		{
+			name:    "print diagnostics in detail",
+			verbose: true,
+			expected: filename + ":1:20: error: Invalid block definition\n" +
+				"\n" +
+				"  1 | variable \"unquoted\"\n" +
+				"    |                    ^\n" +
+				"\n" +
+				"  A block definition must have block content delimited by \"{\" and \"}\".\n" +
+				"\n" +
+				filename + ":3: warning: Module loaded with legacy HCL parser\n" +
+				"\n" +
+				"  3 |   description = \"The opening brace is only valid on its own line in HCL 1.\"\n" +
+				"\n" +
+				"warning: Something is not right\n" +
+				"\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			var buf bytes.Buffer
+			printDiagnostics(&buf, diags, tt.verbose)
+
+			assert.Equal(tt.expected, buf.String())
+		})
+	}
+}
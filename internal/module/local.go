@@ -0,0 +1,24 @@
+package module
+
+import (
+	"github.com/terraform-docs/terraform-docs/pkg/tfconf"
+)
+
+type localsSortedByName []*tfconf.Local
+
+func (a localsSortedByName) Len() int      { return len(a) }
+func (a localsSortedByName) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a localsSortedByName) Less(i, j int) bool {
+	return a[i].Name < a[j].Name
+}
+
+type localsSortedByPosition []*tfconf.Local
+
+func (a localsSortedByPosition) Len() int      { return len(a) }
+func (a localsSortedByPosition) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a localsSortedByPosition) Less(i, j int) bool {
+	if a[i].Position.Filename == a[j].Position.Filename {
+		return a[i].Position.Line < a[j].Position.Line
+	}
+	return a[i].Position.Filename < a[j].Position.Filename
+}
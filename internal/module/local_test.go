@@ -0,0 +1,72 @@
+package module
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/pkg/tfconf"
+)
+
+func TestLocalsSortedByName(t *testing.T) {
+	assert := assert.New(t)
+	locals := sampleLocals()
+
+	sort.Sort(localsSortedByName(locals))
+
+	expected := []string{"a", "b", "c", "d", "e"}
+	actual := make([]string, len(locals))
+
+	for k, l := range locals {
+		actual[k] = l.Name
+	}
+
+	assert.Equal(expected, actual)
+}
+
+func TestLocalsSortedByPosition(t *testing.T) {
+	assert := assert.New(t)
+	locals := sampleLocals()
+
+	sort.Sort(localsSortedByPosition(locals))
+
+	expected := []string{"c", "b", "d", "a", "e"}
+	actual := make([]string, len(locals))
+
+	for k, l := range locals {
+		actual[k] = l.Name
+	}
+
+	assert.Equal(expected, actual)
+}
+
+func sampleLocals() []*tfconf.Local {
+	return []*tfconf.Local{
+		{
+			Name:       "d",
+			Expression: `"${var.name}-d"`,
+			Position:   tfconf.Position{Filename: "foo/main.tf", Line: 21},
+		},
+		{
+			Name:       "b",
+			Expression: "true",
+			Position:   tfconf.Position{Filename: "foo/main.tf", Line: 13},
+		},
+		{
+			Name:       "a",
+			Expression: "var.a",
+			Position:   tfconf.Position{Filename: "foo/main.tf", Line: 39},
+		},
+		{
+			Name:       "c",
+			Expression: "{}",
+			Position:   tfconf.Position{Filename: "foo/locals.tf", Line: 5},
+		},
+		{
+			Name:       "e",
+			Expression: "[]",
+			Position:   tfconf.Position{Filename: "foo/main.tf", Line: 47},
+		},
+	}
+}
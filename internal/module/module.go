@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"sort"
 	"strings"
 
@@ -19,7 +20,7 @@ import (
 // LoadWithOptions returns new instance of Module with all the inputs and
 // outputs discovered from provided 'path' containing Terraform config
 func LoadWithOptions(options *Options) (*tfconf.Module, error) {
-	tfmodule, err := loadModule(options.Path)
+	tfmodule, err := loadModuleWithOptions(options)
 	if err != nil {
 		return nil, err
 	}
@@ -32,10 +33,23 @@ func LoadWithOptions(options *Options) (*tfconf.Module, error) {
 }
 
 func loadModule(path string) (*tfconfig.Module, error) {
-	module, diag := tfconfig.LoadModule(path)
+	return loadModuleWithOptions(&Options{Path: path})
+}
+
+// loadModuleWithOptions loads the Terraform module from 'options.Path' and
+// prints the diagnostics of loading it to 'options.Diagnostics', if set. In
+// strict mode any diagnostics, including warnings, make loading fail.
+func loadModuleWithOptions(options *Options) (*tfconfig.Module, error) {
+	module, diag := tfconfig.LoadModule(options.Path)
+	if options.Diagnostics != nil {
+		printDiagnostics(options.Diagnostics, diag, options.Verbose)
+	}
 	if diag != nil && diag.HasErrors() {
 		return nil, diag
 	}
+	if options.Strict && len(diag) > 0 {
+		return nil, fmt.Errorf("warnings found in strict mode: %v", diag)
+	}
 	return module, nil
 }
 
@@ -44,22 +58,34 @@ func loadModuleItems(tfmodule *tfconfig.Module, options *Options) (*tfconf.Modul
 	if err != nil {
 		return nil, err
 	}
+	footer, err := loadFooter(options)
+	if err != nil {
+		return nil, err
+	}
 
 	inputs, required, optional := loadInputs(tfmodule)
 	outputs, err := loadOutputs(tfmodule, options)
 	if err != nil {
 		return nil, err
 	}
-	providers := loadProviders(tfmodule)
-	requirements := loadRequirements(tfmodule)
+	locals := loadLocals(tfmodule)
+	providers := loadProviders(tfmodule, options)
+	modulecalls := loadModuleCalls(tfmodule)
+	resources := loadResources(tfmodule, options)
+	requirements := loadRequirements(tfmodule, options)
 
 	return &tfconf.Module{
 		Header:       header,
 		Inputs:       inputs,
 		Outputs:      outputs,
+		Locals:       locals,
 		Providers:    providers,
+		Modules:      modulecalls,
+		Resources:    resources,
 		Requirements: requirements,
+		Footer:       footer,
 
+		Path:           options.Path,
 		RequiredInputs: required,
 		OptionalInputs: optional,
 	}, nil
@@ -69,60 +95,105 @@ func getFileFormat(filename string) string {
 	if filename == "" {
 		return ""
 	}
+	if strings.HasSuffix(filename, ".tf.json") {
+		return ".tf.json"
+	}
 	last := strings.LastIndex(filename, ".")
 	if last == -1 {
 		return ""
 	}
 	return filename[last:]
 }
-func isFileFormatSupported(filename string) (bool, error) {
+func isFileFormatSupported(filename string, section string) (bool, error) {
 	if filename == "" {
-		return false, fmt.Errorf("--header-from value is missing")
+		return false, fmt.Errorf("--%s-from value is missing", section)
 	}
 	switch getFileFormat(filename) {
-	case ".adoc", ".md", ".tf", ".txt":
+	case ".adoc", ".md", ".tf", ".tf.json", ".txt":
 		return true, nil
 	}
-	return false, fmt.Errorf("only .adoc, .md, .tf and .txt formats are supported to read header from")
+	return false, fmt.Errorf("only .adoc, .md, .tf, .tf.json and .txt formats are supported to read %s from", section)
 }
 
 func loadHeader(options *Options) (string, error) {
 	if !options.ShowHeader {
 		return "", nil
 	}
-	if ok, err := isFileFormatSupported(options.HeaderFromFile); !ok {
+	return loadSection(options, options.HeaderFromFile, "header")
+}
+
+func loadFooter(options *Options) (string, error) {
+	if !options.ShowFooter || options.FooterFromFile == "" {
+		return "", nil
+	}
+	return loadSection(options, options.FooterFromFile, "footer")
+}
+
+// loadSection reads the content of 'section' (i.e. header or footer) from
+// the given 'file', relative to the path of the module.
+func loadSection(options *Options, file string, section string) (string, error) {
+	if ok, err := isFileFormatSupported(file, section); !ok {
 		return "", err
 	}
-	filename := filepath.Join(options.Path, options.HeaderFromFile)
+	filename := filepath.Join(options.Path, file)
 	if info, err := os.Stat(filename); os.IsNotExist(err) || info.IsDir() {
-		if options.HeaderFromFile != "main.tf" {
+		if file != "main.tf" {
 			return "", err // user explicitly asked for a file which doesn't exist
 		}
 		return "", nil // absorb the error to not break workflow of users who don't have 'main.tf at all
 	}
-	if getFileFormat(options.HeaderFromFile) != ".tf" {
-		content, err := ioutil.ReadFile(filename)
-		if err != nil {
-			return "", err
-		}
-		return string(content), nil
+	switch getFileFormat(file) {
+	case ".tf":
+		return loadHeaderComments(filename, options.HeaderStopAt)
+	case ".tf.json":
+		return loadHeaderJSON(filename)
+	}
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return "", err
 	}
+	return string(content), nil
+}
+
+// loadHeaderComments extracts the header from the comments at the top of a
+// .tf file, which can be either a '/* ... */' block comment or consecutive
+// lines of '#' or '//' comments. Reading the header stops at the first blank
+// line, or if 'stopAt' is 'non-comment' at the first line of code, in which
+// case blank lines between multiple blocks of comments are kept.
+func loadHeaderComments(filename string, stopAt string) (string, error) {
 	lines := reader.Lines{
 		FileName: filename,
 		LineNum:  -1,
 		Condition: func(line string) bool {
 			line = strings.TrimSpace(line)
-			return strings.HasPrefix(line, "/*") || strings.HasPrefix(line, "*") || strings.HasPrefix(line, "*/")
+			if line == "" {
+				return stopAt == "non-comment"
+			}
+			for _, prefix := range []string{"/*", "*", "#", "//"} {
+				if strings.HasPrefix(line, prefix) {
+					return true
+				}
+			}
+			return false
 		},
 		Parser: func(line string) (string, bool) {
 			line = strings.TrimSpace(line)
 			if strings.HasPrefix(line, "/*") || strings.HasPrefix(line, "*/") {
 				return "", false
 			}
-			if line == "*" {
-				return "", true
+			for _, prefix := range []string{"*", "#", "//"} {
+				if line == prefix {
+					return "", true
+				}
+				if strings.HasPrefix(line, prefix+" ") {
+					return strings.TrimPrefix(line, prefix+" "), true
+				}
+			}
+			for _, prefix := range []string{"#", "//"} {
+				if strings.HasPrefix(line, prefix) {
+					return strings.TrimPrefix(line, prefix), true
+				}
 			}
-			line = strings.TrimPrefix(line, "* ")
 			return line, true
 		},
 	}
@@ -130,7 +201,36 @@ func loadHeader(options *Options) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return strings.Join(header, "\n"), nil
+	return strings.Trim(strings.Join(header, "\n"), "\n"), nil
+}
+
+// loadHeaderJSON extracts the header from the top level '//' (i.e. comment)
+// or 'description' key of a .tf.json file. The value can be either a string
+// or a list of strings, which are joined as separate lines.
+func loadHeaderJSON(filename string) (string, error) {
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return "", err
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(content, &doc); err != nil {
+		return "", fmt.Errorf("%s: %v", filename, err)
+	}
+	for _, key := range []string{"//", "description"} {
+		switch value := doc[key].(type) {
+		case string:
+			return value, nil
+		case []interface{}:
+			lines := make([]string, 0, len(value))
+			for _, line := range value {
+				if s, ok := line.(string); ok {
+					lines = append(lines, s)
+				}
+			}
+			return strings.Join(lines, "\n"), nil
+		}
+	}
+	return "", nil
 }
 
 func loadInputs(tfmodule *tfconfig.Module) ([]*tfconf.Input, []*tfconf.Input, []*tfconf.Input) {
@@ -141,21 +241,32 @@ func loadInputs(tfmodule *tfconfig.Module) ([]*tfconf.Input, []*tfconf.Input, []
 	for _, input := range tfmodule.Variables {
 		// convert CRLF to LF early on (https://github.com/terraform-docs/terraform-docs/issues/305)
 		inputDescription := strings.Replace(input.Description, "\r\n", "\n", -1)
-		if inputDescription == "" {
-			inputDescription = loadComments(input.Pos.Filename, input.Pos.Line)
-		}
+		inputDescription, inputTags := loadDescription(inputDescription, input.Pos.Filename, input.Pos.Line)
 
 		i := &tfconf.Input{
-			Name:        input.Name,
-			Type:        types.TypeOf(input.Type, input.Default),
-			Description: types.String(inputDescription),
-			Default:     types.ValueOf(input.Default),
-			Required:    input.Required,
+			Name:          input.Name,
+			Type:          types.TypeOf(input.Type, input.Default),
+			TypeSource:    input.Type,
+			Description:   types.String(inputDescription),
+			Default:       types.ValueOf(input.Default),
+			DefaultSource: unindentExpression(input.DefaultSource),
+			Required:      input.Required,
+			Sensitive:     input.Sensitive,
+			Nullable:      input.Nullable == nil || *input.Nullable,
+			Tags:          inputTags,
 			Position: tfconf.Position{
 				Filename: input.Pos.Filename,
 				Line:     input.Pos.Line,
 			},
 		}
+		i.Structure = loadType(string(i.Type))
+
+		for _, validation := range input.Validations {
+			i.Validations = append(i.Validations, &tfconf.Validation{
+				Condition:    validation.Condition,
+				ErrorMessage: types.String(validation.ErrorMessage),
+			})
+		}
 
 		inputs = append(inputs, i)
 		if i.HasDefault() {
@@ -178,13 +289,12 @@ func loadOutputs(tfmodule *tfconfig.Module, options *Options) ([]*tfconf.Output,
 		}
 	}
 	for _, o := range tfmodule.Outputs {
-		description := o.Description
-		if description == "" {
-			description = loadComments(o.Pos.Filename, o.Pos.Line)
-		}
+		description, tags := loadDescription(o.Description, o.Pos.Filename, o.Pos.Line)
 		output := &tfconf.Output{
 			Name:        o.Name,
 			Description: types.String(description),
+			Sensitive:   o.Sensitive,
+			Tags:        tags,
 			Position: tfconf.Position{
 				Filename: o.Pos.Filename,
 				Line:     o.Pos.Line,
@@ -192,8 +302,8 @@ func loadOutputs(tfmodule *tfconfig.Module, options *Options) ([]*tfconf.Output,
 			ShowValue: options.OutputValues,
 		}
 		if options.OutputValues {
-			output.Sensitive = values[output.Name].Sensitive
-			if values[output.Name].Sensitive {
+			output.Sensitive = output.Sensitive || values[output.Name].Sensitive
+			if output.Sensitive {
 				output.Value = types.ValueOf(`<sensitive>`)
 			} else {
 				output.Value = types.ValueOf(values[output.Name].Value)
@@ -226,27 +336,80 @@ func loadOutputValues(options *Options) (map[string]*TerraformOutput, error) {
 	return terraformOutputs, err
 }
 
-func loadProviders(tfmodule *tfconfig.Module) []*tfconf.Provider {
-	resources := []map[string]*tfconfig.Resource{tfmodule.ManagedResources, tfmodule.DataResources}
+func loadLocals(tfmodule *tfconfig.Module) []*tfconf.Local {
+	locals := make([]*tfconf.Local, 0, len(tfmodule.Locals))
+	for _, l := range tfmodule.Locals {
+		locals = append(locals, &tfconf.Local{
+			Name:        l.Name,
+			Description: types.String(loadComments(l.Pos.Filename, l.Pos.Line)),
+			Expression:  unindentExpression(l.Expression),
+			Position: tfconf.Position{
+				Filename: l.Pos.Filename,
+				Line:     l.Pos.Line,
+			},
+		})
+	}
+	return locals
+}
+
+// unindentExpression removes the common indentation of all the lines of a
+// multi-line expression except the first one, which starts right after the
+// equal sign and hence isn't indented.
+func unindentExpression(expr string) string {
+	lines := strings.Split(expr, "\n")
+	if len(lines) == 1 {
+		return expr
+	}
+	indent := -1
+	for _, line := range lines[1:] {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		n := len(line) - len(strings.TrimLeft(line, " \t"))
+		if indent == -1 || n < indent {
+			indent = n
+		}
+	}
+	for i, line := range lines[1:] {
+		if len(line) >= indent {
+			lines[i+1] = line[indent:]
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func loadProviders(tfmodule *tfconfig.Module, options *Options) []*tfconf.Provider {
 	discovered := make(map[string]*tfconf.Provider)
+
+	// providers implied by resources are overridden by the configurations
+	// expected from callers, and both of them are overridden by the ones
+	// declared in the module itself.
+	resources := []map[string]*tfconfig.Resource{tfmodule.ManagedResources, tfmodule.DataResources}
 	for _, resource := range resources {
 		for _, r := range resource {
-			var version = ""
-			if rv, ok := tfmodule.RequiredProviders[r.Provider.Name]; ok && len(rv.VersionConstraints) > 0 {
-				version = strings.Join(rv.VersionConstraints, " ")
-			}
-			key := fmt.Sprintf("%s.%s", r.Provider.Name, r.Provider.Alias)
-			discovered[key] = &tfconf.Provider{
-				Name:    r.Provider.Name,
-				Alias:   types.String(r.Provider.Alias),
-				Version: types.String(version),
-				Position: tfconf.Position{
-					Filename: r.Pos.Filename,
-					Line:     r.Pos.Line,
-				},
+			provider := newProvider(tfmodule, options, r.Provider.Name, r.Provider.Alias, "implied")
+			provider.Position = tfconf.Position{
+				Filename: r.Pos.Filename,
+				Line:     r.Pos.Line,
 			}
+			discovered[provider.FullName()] = provider
+		}
+	}
+	for _, requirement := range tfmodule.RequiredProviders {
+		for _, ref := range requirement.ConfigurationAliases {
+			provider := newProvider(tfmodule, options, ref.Name, ref.Alias, "expected")
+			discovered[provider.FullName()] = provider
+		}
+	}
+	for _, pc := range tfmodule.ProviderConfigs {
+		provider := newProvider(tfmodule, options, pc.Name, pc.Alias, "declared")
+		provider.Position = tfconf.Position{
+			Filename: pc.Pos.Filename,
+			Line:     pc.Pos.Line,
 		}
+		discovered[provider.FullName()] = provider
 	}
+
 	providers := make([]*tfconf.Provider, 0, len(discovered))
 	for _, provider := range discovered {
 		providers = append(providers, provider)
@@ -254,7 +417,98 @@ func loadProviders(tfmodule *tfconfig.Module) []*tfconf.Provider {
 	return providers
 }
 
-func loadRequirements(tfmodule *tfconfig.Module) []*tfconf.Requirement {
+// newProvider returns a Provider of 'kind' with its version and source
+// taken from 'required_providers' of the module, if any.
+func newProvider(tfmodule *tfconfig.Module, options *Options, name string, alias string, kind string) *tfconf.Provider {
+	var version = ""
+	var source = ""
+	if rv, ok := tfmodule.RequiredProviders[name]; ok {
+		version = strings.Join(rv.VersionConstraints, " ")
+		source = rv.Source
+	}
+	return &tfconf.Provider{
+		Name:    name,
+		Alias:   types.String(alias),
+		Version: types.String(version),
+		Source:  types.String(source),
+		URL:     types.String(providerURL(options.ProviderLink, source)),
+		Kind:    kind,
+	}
+}
+
+func loadModuleCalls(tfmodule *tfconfig.Module) []*tfconf.ModuleCall {
+	var modules = make([]*tfconf.ModuleCall, 0, len(tfmodule.ModuleCalls))
+	for _, m := range tfmodule.ModuleCalls {
+		modules = append(modules, &tfconf.ModuleCall{
+			Name:    m.Name,
+			Source:  m.Source,
+			Version: types.String(m.Version),
+			Position: tfconf.Position{
+				Filename: m.Pos.Filename,
+				Line:     m.Pos.Line,
+			},
+		})
+	}
+	return modules
+}
+
+func loadResources(tfmodule *tfconfig.Module, options *Options) []*tfconf.Resource {
+	var resources = make([]*tfconf.Resource, 0, len(tfmodule.ManagedResources)+len(tfmodule.DataResources))
+	for _, resource := range []map[string]*tfconfig.Resource{tfmodule.ManagedResources, tfmodule.DataResources} {
+		for _, r := range resource {
+			provider := r.Provider.Name
+			if r.Provider.Alias != "" {
+				provider = fmt.Sprintf("%s.%s", r.Provider.Name, r.Provider.Alias)
+			}
+			resources = append(resources, &tfconf.Resource{
+				Address:  r.MapKey(),
+				Type:     r.Type,
+				Mode:     r.Mode.String(),
+				Provider: provider,
+				URL:      types.String(resourceURL(options.ResourceLink, r)),
+				Position: tfconf.Position{
+					Filename: relativeFilename(tfmodule.Path, r.Pos.Filename),
+					Line:     r.Pos.Line,
+				},
+			})
+		}
+	}
+	return resources
+}
+
+// relativeFilename returns 'filename' relative to the module 'path', to not
+// depend on how the path of the module has been passed in.
+func relativeFilename(path string, filename string) string {
+	if rel, err := filepath.Rel(path, filename); err == nil {
+		return filepath.ToSlash(rel)
+	}
+	return filename
+}
+
+// resourceURL returns the link to documentation of resource 'r' built from
+// 'link' template, in which '{provider}', '{kind}' ('resources' or
+// 'data-sources') and '{type}' (resource type without provider prefix)
+// placeholders get replaced. Empty 'link' results in empty URL.
+func resourceURL(link string, r *tfconfig.Resource) string {
+	if link == "" {
+		return ""
+	}
+	kind := "resources"
+	if r.Mode == tfconfig.DataResourceMode {
+		kind = "data-sources"
+	}
+	name := r.Type
+	if i := strings.Index(name, "_"); i != -1 {
+		name = name[i+1:]
+	}
+	return strings.NewReplacer(
+		"{provider}", r.Provider.Name,
+		"{kind}", kind,
+		"{type}", name,
+	).Replace(link)
+}
+
+func loadRequirements(tfmodule *tfconfig.Module, options *Options) []*tfconf.Requirement {
 	var requirements = make([]*tfconf.Requirement, 0)
 	for _, core := range tfmodule.RequiredCore {
 		requirements = append(requirements, &tfconf.Requirement{
@@ -268,21 +522,61 @@ func loadRequirements(tfmodule *tfconfig.Module) []*tfconf.Requirement {
 	}
 	sort.Strings(names)
 	for _, name := range names {
-		for _, version := range tfmodule.RequiredProviders[name].VersionConstraints {
+		source := tfmodule.RequiredProviders[name].Source
+		versions := tfmodule.RequiredProviders[name].VersionConstraints
+		if len(versions) == 0 && source != "" {
+			versions = []string{""}
+		}
+		for _, version := range versions {
 			requirements = append(requirements, &tfconf.Requirement{
 				Name:    name,
 				Version: types.String(version),
+				Source:  types.String(source),
+				URL:     types.String(providerURL(options.ProviderLink, source)),
 			})
 		}
 	}
 	return requirements
 }
 
+// providerURL returns the link to documentation of provider with 'source'
+// address (e.g. 'hashicorp/aws') built from 'link' template, in which
+// '{hostname}', '{namespace}' and '{type}' placeholders get replaced.
+// Hostname defaults to 'registry.terraform.io' and namespace to 'hashicorp'
+// if they are omitted from the source address. Empty 'link' or 'source'
+// results in empty URL.
+func providerURL(link string, source string) string {
+	if link == "" || source == "" {
+		return ""
+	}
+	hostname := "registry.terraform.io"
+	namespace := "hashicorp"
+	parts := strings.Split(source, "/")
+	switch len(parts) {
+	case 1:
+	case 2:
+		namespace = parts[0]
+	default:
+		hostname = parts[len(parts)-3]
+		namespace = parts[len(parts)-2]
+	}
+	return strings.NewReplacer(
+		"{hostname}", hostname,
+		"{namespace}", namespace,
+		"{type}", parts[len(parts)-1],
+	).Replace(link)
+}
+
 func loadComments(filename string, lineNum int) string {
+	return strings.Join(loadCommentLines(filename, lineNum), " ")
+}
+
+func loadCommentLines(filename string, lineNum int) []string {
 	lines := reader.Lines{
 		FileName: filename,
 		LineNum:  lineNum,
 		Condition: func(line string) bool {
+			line = strings.TrimSpace(line)
 			return strings.HasPrefix(line, "#") || strings.HasPrefix(line, "//")
 		},
 		Parser: func(line string) (string, bool) {
@@ -295,9 +589,72 @@ func loadComments(filename string, lineNum int) string {
 	}
 	comment, err := lines.Extract()
 	if err != nil {
-		return "" // absorb the error, we don't need to bubble it up or break the execution
+		return nil // absorb the error, we don't need to bubble it up or break the execution
 	}
-	return strings.Join(comment, " ")
+	return comment
+}
+
+// loadDescription returns the description of a variable or an output, either
+// from its 'description' attribute or from the comments preceding its block,
+// alongside the annotations declared in either of them.
+func loadDescription(description string, filename string, lineNum int) (string, tfconf.Tags) {
+	comments, tags := loadTags(loadCommentLines(filename, lineNum))
+	if description == "" {
+		return strings.Join(comments, " "), tags
+	}
+	lines, descriptionTags := loadTags(strings.Split(description, "\n"))
+	if descriptionTags == nil {
+		return description, tags
+	}
+	if tags == nil {
+		tags = tfconf.Tags{}
+	}
+	for name, value := range descriptionTags {
+		tags[name] = value
+	}
+	result := strings.TrimRight(strings.Join(lines, "\n"), " \t\n")
+	if result != "" && strings.HasSuffix(description, "\n") {
+		result += "\n"
+	}
+	return result, tags
+}
+
+var tagPattern = regexp.MustCompile(`^@([a-zA-Z][a-zA-Z0-9_-]*)(\s+(.*))?$`)
+
+// loadTags extracts '@tag value' annotations out of 'lines' and returns the
+// rest of the lines alongside the tags. Lines following a tag, up to the next
+// one, are considered as continuation of its value (e.g. multi-line examples).
+func loadTags(lines []string) ([]string, tfconf.Tags) {
+	var rest []string
+	var tags tfconf.Tags
+	var current string
+	for _, line := range lines {
+		if match := tagPattern.FindStringSubmatch(strings.TrimSpace(line)); match != nil {
+			if tags == nil {
+				tags = tfconf.Tags{}
+			}
+			current = match[1]
+			value := strings.TrimSpace(match[3])
+			if previous, ok := tags[current]; ok && previous != "" {
+				value = previous + "\n" + value
+			}
+			tags[current] = value
+			continue
+		}
+		if current == "" {
+			rest = append(rest, line)
+			continue
+		}
+		if tags[current] == "" {
+			tags[current] = line
+		} else {
+			tags[current] += "\n" + line
+		}
+	}
+	for name, value := range tags {
+		tags[name] = strings.TrimRight(value, " \t\n")
+	}
+	return rest, tags
 }
 
 func sortItems(tfmodule *tfconf.Module, sortby *SortBy) {
@@ -327,9 +684,27 @@ func sortItems(tfmodule *tfconf.Module, sortby *SortBy) {
 		sort.Sort(outputsSortedByPosition(tfmodule.Outputs))
 	}
 
+	if sortby.Name || sortby.Type {
+		sort.Sort(localsSortedByName(tfmodule.Locals))
+	} else {
+		sort.Sort(localsSortedByPosition(tfmodule.Locals))
+	}
+
 	if sortby.Name || sortby.Type {
 		sort.Sort(providersSortedByName(tfmodule.Providers))
 	} else {
 		sort.Sort(providersSortedByPosition(tfmodule.Providers))
 	}
+
+	if sortby.Name || sortby.Type {
+		sort.Sort(modulecallsSortedByName(tfmodule.Modules))
+	} else {
+		sort.Sort(modulecallsSortedByPosition(tfmodule.Modules))
+	}
+
+	if sortby.Name || sortby.Type {
+		sort.Sort(resourcesSortedByAddress(tfmodule.Resources))
+	} else {
+		sort.Sort(resourcesSortedByPosition(tfmodule.Resources))
+	}
 }
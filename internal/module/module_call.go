@@ -0,0 +1,24 @@
+package module
+
+import (
+	"github.com/terraform-docs/terraform-docs/pkg/tfconf"
+)
+
+type modulecallsSortedByName []*tfconf.ModuleCall
+
+func (a modulecallsSortedByName) Len() int      { return len(a) }
+func (a modulecallsSortedByName) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a modulecallsSortedByName) Less(i, j int) bool {
+	return a[i].Name < a[j].Name
+}
+
+type modulecallsSortedByPosition []*tfconf.ModuleCall
+
+func (a modulecallsSortedByPosition) Len() int      { return len(a) }
+func (a modulecallsSortedByPosition) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a modulecallsSortedByPosition) Less(i, j int) bool {
+	if a[i].Position.Filename == a[j].Position.Filename {
+		return a[i].Position.Line < a[j].Position.Line
+	}
+	return a[i].Position.Filename < a[j].Position.Filename
+}
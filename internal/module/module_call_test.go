@@ -0,0 +1,78 @@
+package module
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/types"
+	"github.com/terraform-docs/terraform-docs/pkg/tfconf"
+)
+
+func TestModuleCallsSortedByName(t *testing.T) {
+	assert := assert.New(t)
+	modules := sampleModuleCalls()
+
+	sort.Sort(modulecallsSortedByName(modules))
+
+	expected := []string{"a", "b", "c", "d", "e"}
+	actual := make([]string, len(modules))
+
+	for k, m := range modules {
+		actual[k] = m.Name
+	}
+
+	assert.Equal(expected, actual)
+}
+
+func TestModuleCallsSortedByPosition(t *testing.T) {
+	assert := assert.New(t)
+	modules := sampleModuleCalls()
+
+	sort.Sort(modulecallsSortedByPosition(modules))
+
+	expected := []string{"b", "d", "a", "e", "c"}
+	actual := make([]string, len(modules))
+
+	for k, m := range modules {
+		actual[k] = m.Name
+	}
+
+	assert.Equal(expected, actual)
+}
+
+func sampleModuleCalls() []*tfconf.ModuleCall {
+	return []*tfconf.ModuleCall{
+		{
+			Name:     "d",
+			Source:   "foo/bar",
+			Version:  types.String("1.3.2"),
+			Position: tfconf.Position{Filename: "foo/main.tf", Line: 21},
+		},
+		{
+			Name:     "b",
+			Source:   "./modules/b",
+			Version:  types.String(""),
+			Position: tfconf.Position{Filename: "foo/main.tf", Line: 13},
+		},
+		{
+			Name:     "a",
+			Source:   "git::https://example.com/a.git",
+			Version:  types.String(""),
+			Position: tfconf.Position{Filename: "foo/main.tf", Line: 39},
+		},
+		{
+			Name:     "c",
+			Source:   "foo/baz",
+			Version:  types.String("~> 0.5.0"),
+			Position: tfconf.Position{Filename: "foo/modules.tf", Line: 5},
+		},
+		{
+			Name:     "e",
+			Source:   "foo/bar",
+			Version:  types.String("> 1.0"),
+			Position: tfconf.Position{Filename: "foo/main.tf", Line: 47},
+		},
+	}
+}
@@ -1,10 +1,15 @@
 package module
 
 import (
+	"bytes"
 	"path/filepath"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/internal/types"
+	"github.com/terraform-docs/terraform-docs/pkg/tfconf"
 )
 
 func TestLoadModuleWithOptions(t *testing.T) {
@@ -53,6 +58,55 @@ func TestLoadModule(t *testing.T) {
 	}
 }
 
+func TestLoadModuleStrict(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		strict  bool
+		wantErr bool
+	}{
+		{
+			name:    "load module without warnings",
+			path:    "full-example",
+			strict:  true,
+			wantErr: false,
+		},
+		{
+			name:    "load module with warnings",
+			path:    "legacy-module",
+			strict:  false,
+			wantErr: false,
+		},
+		{
+			name:    "load module with warnings in strict mode",
+			path:    "legacy-module",
+			strict:  true,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			var buf bytes.Buffer
+			_, err := loadModuleWithOptions(&Options{
+				Path:        filepath.Join("testdata", tt.path),
+				Strict:      tt.strict,
+				Diagnostics: &buf,
+			})
+			if tt.wantErr {
+				assert.NotNil(err)
+			} else {
+				assert.Nil(err)
+			}
+			if tt.path == "legacy-module" {
+				assert.Contains(buf.String(), "warning: Module loaded with legacy HCL parser")
+			} else {
+				assert.Empty(buf.String())
+			}
+		})
+	}
+}
+
 func TestGetFileFormat(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -74,6 +128,11 @@ func TestGetFileFormat(t *testing.T) {
 			filename: "main_file.tf",
 			expected: ".tf",
 		},
+		{
+			name:     "get file format",
+			filename: "main.tf.json",
+			expected: ".tf.json",
+		},
 		{
 			name:     "get file format",
 			filename: "main.file_tf",
@@ -128,6 +187,13 @@ func TestIsFileFormatSupported(t *testing.T) {
 			wantErr:  false,
 			errText:  "",
 		},
+		{
+			name:     "is file format supported",
+			filename: "main.tf.json",
+			expected: true,
+			wantErr:  false,
+			errText:  "",
+		},
 		{
 			name:     "is file format supported",
 			filename: "main.txt",
@@ -140,7 +206,7 @@ func TestIsFileFormatSupported(t *testing.T) {
 			filename: "main.doc",
 			expected: false,
 			wantErr:  true,
-			errText:  "only .adoc, .md, .tf and .txt formats are supported to read header from",
+			errText:  "only .adoc, .md, .tf, .tf.json and .txt formats are supported to read header from",
 		},
 		{
 			name:     "is file format supported",
@@ -153,7 +219,7 @@ func TestIsFileFormatSupported(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			assert := assert.New(t)
-			actual, err := isFileFormatSupported(tt.filename)
+			actual, err := isFileFormatSupported(tt.filename, "header")
 			if tt.wantErr {
 				assert.NotNil(err)
 				assert.Equal(tt.errText, err.Error())
@@ -236,7 +302,7 @@ func TestLoadHeader(t *testing.T) {
 			header:   "wrong-formate.docx",
 			expected: "",
 			wantErr:  true,
-			errText:  "only .adoc, .md, .tf and .txt formats are supported to read header from",
+			errText:  "only .adoc, .md, .tf, .tf.json and .txt formats are supported to read header from",
 		},
 		{
 			name:     "load module header from path",
@@ -279,6 +345,127 @@ func TestLoadHeader(t *testing.T) {
 	}
 }
 
+func TestLoadFooter(t *testing.T) {
+	tests := []struct {
+		name     string
+		footer   string
+		show     bool
+		expected string
+		wantErr  bool
+		errText  string
+	}{
+		{
+			name:     "load module footer from path",
+			footer:   "doc.md",
+			show:     true,
+			expected: "# Custom Header\n\nExample of 'foo_bar' module in `foo_bar.tf`.\n\n- list item 1\n- list item 2\n",
+			wantErr:  false,
+			errText:  "",
+		},
+		{
+			name:     "load module footer from path",
+			footer:   "doc.md",
+			show:     false,
+			expected: "",
+			wantErr:  false,
+			errText:  "",
+		},
+		{
+			name:     "load module footer from path",
+			footer:   "",
+			show:     true,
+			expected: "",
+			wantErr:  false,
+			errText:  "",
+		},
+		{
+			name:     "load module footer from path",
+			footer:   "non-existent.md",
+			show:     true,
+			expected: "",
+			wantErr:  true,
+			errText:  "stat testdata/full-example/non-existent.md: no such file or directory",
+		},
+		{
+			name:     "load module footer from path",
+			footer:   "wrong-formate.docx",
+			show:     true,
+			expected: "",
+			wantErr:  true,
+			errText:  "only .adoc, .md, .tf, .tf.json and .txt formats are supported to read footer from",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			options := &Options{Path: filepath.Join("testdata", "full-example"), FooterFromFile: tt.footer, ShowFooter: tt.show}
+			actual, err := loadFooter(options)
+			if tt.wantErr {
+				assert.NotNil(err)
+				assert.Equal(tt.errText, err.Error())
+			} else {
+				assert.Nil(err)
+				assert.Equal(tt.expected, actual)
+			}
+		})
+	}
+}
+
+func TestLoadHeaderComments(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		stopAt   string
+		expected string
+	}{
+		{
+			name:     "load module header from hash comments",
+			header:   "hash.tf",
+			stopAt:   "blank-line",
+			expected: "Custom Header:\n\nExample of 'foo_bar' module in `foo_bar.tf`.\n\n- list item 1\n- list item 2",
+		},
+		{
+			name:     "load module header from hash comments",
+			header:   "hash.tf",
+			stopAt:   "non-comment",
+			expected: "Custom Header:\n\nExample of 'foo_bar' module in `foo_bar.tf`.\n\n- list item 1\n- list item 2\n\nComment of the resource, not part of the header",
+		},
+		{
+			name:     "load module header from slash comments",
+			header:   "slash.tf",
+			stopAt:   "blank-line",
+			expected: "Custom Header:\n\nExample of 'foo_bar' module in `foo_bar.tf`.\n\n- list item 1\n- list item 2",
+		},
+		{
+			name:     "load module header from comment key of json",
+			header:   "main.tf.json",
+			stopAt:   "blank-line",
+			expected: "Custom Header:\n\nExample of 'foo_bar' module in `foo_bar.tf`.",
+		},
+		{
+			name:     "load module header from description key of json",
+			header:   "description.tf.json",
+			stopAt:   "blank-line",
+			expected: "Custom Header:\n\nExample of 'foo_bar' module in `foo_bar.tf`.",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			options := &Options{
+				Path:           filepath.Join("testdata", "header-comments"),
+				HeaderFromFile: tt.header,
+				HeaderStopAt:   tt.stopAt,
+				ShowHeader:     true,
+			}
+			actual, err := loadHeader(options)
+
+			assert.Nil(err)
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
+
 func TestLoadInputs(t *testing.T) {
 	type expected struct {
 		inputs    int
@@ -340,6 +527,53 @@ func TestLoadInputs(t *testing.T) {
 	}
 }
 
+func TestLoadInputsValidations(t *testing.T) {
+	assert := assert.New(t)
+	module, _ := loadModule(filepath.Join("testdata", "full-example"))
+	inputs, _, _ := loadInputs(module)
+
+	for _, input := range inputs {
+		if input.Name != "F" {
+			assert.False(input.HasValidations())
+			continue
+		}
+		assert.True(input.HasValidations())
+		assert.Equal(1, len(input.Validations))
+		assert.Equal("length(var.F) > 0", input.Validations[0].Condition)
+		assert.Equal("F can't be empty.", string(input.Validations[0].ErrorMessage))
+	}
+}
+
+func TestLoadInputsSensitive(t *testing.T) {
+	assert := assert.New(t)
+	module, _ := loadModule(filepath.Join("testdata", "full-example"))
+	inputs, _, _ := loadInputs(module)
+
+	for _, input := range inputs {
+		assert.Equal(input.Name == "G", input.Sensitive)
+	}
+}
+
+func TestLoadInputsDefaultSource(t *testing.T) {
+	assert := assert.New(t)
+	module, _ := loadModule(filepath.Join("testdata", "full-example"))
+	inputs, _, _ := loadInputs(module)
+
+	expected := map[string]string{
+		"A": "",
+		"B": `"b"`,
+		"C": `"c"`,
+		"D": `"d"`,
+		"E": `""`,
+		"F": "",
+		"G": "null",
+	}
+	for _, input := range inputs {
+		assert.Equal(expected[input.Name], input.DefaultSource)
+		assert.Equal(expected[input.Name], input.GetSource())
+	}
+}
+
 func TestLoadInputsLineEnding(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -469,6 +703,123 @@ func TestLoadOutputsValues(t *testing.T) {
 	}
 }
 
+func TestLoadOutputsSensitive(t *testing.T) {
+	tests := []struct {
+		name       string
+		values     bool
+		sensitives []string
+	}{
+		{
+			name:       "load module outputs sensitive from config",
+			values:     false,
+			sensitives: []string{"A"},
+		},
+		{
+			name:       "load module outputs sensitive from config and values",
+			values:     true,
+			sensitives: []string{"A", "C"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			options, _ := NewOptions().With(&Options{
+				OutputValues:     tt.values,
+				OutputValuesPath: filepath.Join("testdata", "full-example", "output-values.json"),
+			})
+			module, _ := loadModule(filepath.Join("testdata", "full-example"))
+			outputs, err := loadOutputs(module, options)
+			assert.Nil(err)
+
+			sensitives := []string{}
+			for _, o := range outputs {
+				if o.Sensitive {
+					sensitives = append(sensitives, o.Name)
+					if tt.values {
+						assert.Equal(types.ValueOf("<sensitive>"), o.Value)
+					}
+				}
+			}
+			sort.Strings(sensitives)
+			assert.Equal(tt.sensitives, sensitives)
+		})
+	}
+}
+
+func TestLoadLocals(t *testing.T) {
+	type expected struct {
+		locals int
+	}
+	tests := []struct {
+		name     string
+		path     string
+		expected expected
+	}{
+		{
+			name: "load module locals from path",
+			path: "full-example",
+			expected: expected{
+				locals: 2,
+			},
+		},
+		{
+			name: "load module locals from path",
+			path: "no-outputs",
+			expected: expected{
+				locals: 0,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			module, _ := loadModule(filepath.Join("testdata", tt.path))
+			locals := loadLocals(module)
+
+			assert.Equal(tt.expected.locals, len(locals))
+		})
+	}
+}
+
+func TestLoadLocalsDescription(t *testing.T) {
+	assert := assert.New(t)
+	module, _ := loadModule(filepath.Join("testdata", "full-example"))
+	locals := loadLocals(module)
+
+	expected := map[string][]string{
+		"prefix": {"Name prefix of all the resources", `"foo"`},
+		"tags":   {"", "{\n  Name = local.prefix\n}"},
+	}
+	for _, l := range locals {
+		assert.Equal(expected[l.Name], []string{string(l.Description), l.Expression})
+	}
+}
+
+func TestUnindentExpression(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		expected   string
+	}{
+		{
+			name:       "unindent single line expression",
+			expression: `"foo"`,
+			expected:   `"foo"`,
+		},
+		{
+			name:       "unindent multi line expression",
+			expression: "{\n    foo = {\n      bar = 1\n    }\n\n  }",
+			expected:   "{\n  foo = {\n    bar = 1\n  }\n\n}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, unindentExpression(tt.expression))
+		})
+	}
+}
+
 func TestLoadProviders(t *testing.T) {
 	type expected struct {
 		providers int
@@ -482,7 +833,7 @@ func TestLoadProviders(t *testing.T) {
 			name: "load module providers from path",
 			path: "full-example",
 			expected: expected{
-				providers: 3,
+				providers: 5,
 			},
 		},
 		{
@@ -497,13 +848,238 @@ func TestLoadProviders(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			assert := assert.New(t)
 			module, _ := loadModule(filepath.Join("testdata", tt.path))
-			providers := loadProviders(module)
+			providers := loadProviders(module, NewOptions())
 
 			assert.Equal(tt.expected.providers, len(providers))
 		})
 	}
 }
 
+func TestLoadProvidersKind(t *testing.T) {
+	assert := assert.New(t)
+	module, _ := loadModule(filepath.Join("testdata", "full-example"))
+	providers := loadProviders(module, NewOptions())
+
+	expected := map[string]string{
+		"aws":         "implied",
+		"aws.east":    "expected",
+		"aws.replica": "declared",
+		"null":        "implied",
+		"tls":         "implied",
+	}
+	actual := make(map[string]string)
+	for _, p := range providers {
+		actual[p.FullName()] = p.Kind
+	}
+	assert.Equal(expected, actual)
+}
+
+func TestLoadProvidersSource(t *testing.T) {
+	tests := []struct {
+		name     string
+		link     string
+		expected map[string][]string
+	}{
+		{
+			name: "load module providers source",
+			link: "",
+			expected: map[string][]string{
+				"aws":  {"hashicorp/aws", ""},
+				"tls":  {"example.com/foo/tls", ""},
+				"null": {"", ""},
+			},
+		},
+		{
+			name: "load module providers source with link",
+			link: "https://{hostname}/providers/{namespace}/{type}/latest",
+			expected: map[string][]string{
+				"aws":  {"hashicorp/aws", "https://registry.terraform.io/providers/hashicorp/aws/latest"},
+				"tls":  {"example.com/foo/tls", "https://example.com/providers/foo/tls/latest"},
+				"null": {"", ""},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			options, _ := NewOptions().With(&Options{
+				ProviderLink: tt.link,
+			})
+			module, _ := loadModule(filepath.Join("testdata", "full-example"))
+
+			providers := loadProviders(module, options)
+			assert.Equal(5, len(providers))
+			for _, p := range providers {
+				assert.Equal(tt.expected[p.Name], []string{string(p.Source), string(p.URL)})
+			}
+
+			requirements := loadRequirements(module, options)
+			assert.Equal(3, len(requirements))
+			for _, r := range requirements[1:] {
+				assert.Equal(tt.expected[r.Name], []string{string(r.Source), string(r.URL)})
+			}
+		})
+	}
+}
+
+func TestProviderURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		link     string
+		source   string
+		expected string
+	}{
+		{
+			name:     "provider url without link",
+			link:     "",
+			source:   "hashicorp/aws",
+			expected: "",
+		},
+		{
+			name:     "provider url without source",
+			link:     "https://{hostname}/{namespace}/{type}",
+			source:   "",
+			expected: "",
+		},
+		{
+			name:     "provider url from type",
+			link:     "https://{hostname}/{namespace}/{type}",
+			source:   "aws",
+			expected: "https://registry.terraform.io/hashicorp/aws",
+		},
+		{
+			name:     "provider url from namespace and type",
+			link:     "https://{hostname}/{namespace}/{type}",
+			source:   "foo/aws",
+			expected: "https://registry.terraform.io/foo/aws",
+		},
+		{
+			name:     "provider url from hostname, namespace and type",
+			link:     "https://{hostname}/{namespace}/{type}",
+			source:   "example.com/foo/aws",
+			expected: "https://example.com/foo/aws",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			assert.Equal(tt.expected, providerURL(tt.link, tt.source))
+		})
+	}
+}
+
+func TestLoadModuleCalls(t *testing.T) {
+	type expected struct {
+		modules int
+	}
+	tests := []struct {
+		name     string
+		path     string
+		expected expected
+	}{
+		{
+			name: "load module calls from path",
+			path: "full-example",
+			expected: expected{
+				modules: 3,
+			},
+		},
+		{
+			name: "load module calls from path",
+			path: "no-providers",
+			expected: expected{
+				modules: 0,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			module, _ := loadModule(filepath.Join("testdata", tt.path))
+			modules := loadModuleCalls(module)
+
+			assert.Equal(tt.expected.modules, len(modules))
+		})
+	}
+}
+
+func TestLoadResources(t *testing.T) {
+	type expected struct {
+		resources int
+		urls      map[string]string
+		positions map[string]string
+	}
+	tests := []struct {
+		name     string
+		path     string
+		link     string
+		expected expected
+	}{
+		{
+			name: "load module resources from path",
+			path: "full-example",
+			link: "",
+			expected: expected{
+				resources: 3,
+				urls: map[string]string{
+					"tls_private_key.baz":              "",
+					"data.aws_caller_identity.current": "",
+					"null_resource.foo":                "",
+				},
+				positions: map[string]string{
+					"tls_private_key.baz":              "main.tf:29",
+					"data.aws_caller_identity.current": "main.tf:31",
+					"null_resource.foo":                "main.tf:35",
+				},
+			},
+		},
+		{
+			name: "load module resources from path with link",
+			path: "full-example",
+			link: "https://registry.terraform.io/providers/hashicorp/{provider}/latest/docs/{kind}/{type}",
+			expected: expected{
+				resources: 3,
+				urls: map[string]string{
+					"tls_private_key.baz":              "https://registry.terraform.io/providers/hashicorp/tls/latest/docs/resources/private_key",
+					"data.aws_caller_identity.current": "https://registry.terraform.io/providers/hashicorp/aws/latest/docs/data-sources/caller_identity",
+					"null_resource.foo":                "https://registry.terraform.io/providers/hashicorp/null/latest/docs/resources/resource",
+				},
+				positions: map[string]string{
+					"tls_private_key.baz":              "main.tf:29",
+					"data.aws_caller_identity.current": "main.tf:31",
+					"null_resource.foo":                "main.tf:35",
+				},
+			},
+		},
+		{
+			name: "load module without resources",
+			path: "no-providers",
+			link: "",
+			expected: expected{
+				resources: 0,
+				urls:      map[string]string{},
+				positions: map[string]string{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			options, _ := NewOptions().With(&Options{
+				ResourceLink: tt.link,
+			})
+			module, _ := loadModule(filepath.Join("testdata", tt.path))
+			resources := loadResources(module, options)
+
+			assert.Equal(tt.expected.resources, len(resources))
+			for _, r := range resources {
+				assert.Equal(tt.expected.urls[r.Address], string(r.URL))
+				assert.Equal(tt.expected.positions[r.Address], r.Position.String())
+			}
+		})
+	}
+}
+
 func TestLoadComments(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -557,6 +1133,101 @@ func TestLoadComments(t *testing.T) {
 	}
 }
 
+func TestLoadTags(t *testing.T) {
+	tests := []struct {
+		name         string
+		lines        []string
+		expectedRest []string
+		expectedTags tfconf.Tags
+	}{
+		{
+			name:         "load tags without any tag",
+			lines:        []string{"foo", "", "bar"},
+			expectedRest: []string{"foo", "", "bar"},
+			expectedTags: nil,
+		},
+		{
+			name:         "load tags with and without value",
+			lines:        []string{"foo", "@deprecated", "@since 2.3.0", "  @group   network  "},
+			expectedRest: []string{"foo"},
+			expectedTags: tfconf.Tags{"deprecated": "", "since": "2.3.0", "group": "network"},
+		},
+		{
+			name:         "load tags with multi-line value",
+			lines:        []string{"foo", "", "@example", "{", "  foo = \"bar\"", "}", "", "@internal"},
+			expectedRest: []string{"foo", ""},
+			expectedTags: tfconf.Tags{"example": "{\n  foo = \"bar\"\n}", "internal": ""},
+		},
+		{
+			name:         "load tags declared multiple times",
+			lines:        []string{"@example foo", "@example bar"},
+			expectedRest: nil,
+			expectedTags: tfconf.Tags{"example": "foo\nbar"},
+		},
+		{
+			name:         "load tags not at the beginning of line",
+			lines:        []string{"contact foo@example.com", "@ bar"},
+			expectedRest: []string{"contact foo@example.com", "@ bar"},
+			expectedTags: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			rest, tags := loadTags(tt.lines)
+			assert.Equal(tt.expectedRest, rest)
+			assert.Equal(tt.expectedTags, tags)
+		})
+	}
+}
+
+func TestLoadDescription(t *testing.T) {
+	tests := []struct {
+		name         string
+		description  string
+		lineNumber   int
+		expected     string
+		expectedTags tfconf.Tags
+	}{
+		{
+			name:         "load description from comments",
+			description:  "",
+			lineNumber:   16,
+			expected:     "A Description in multiple lines",
+			expectedTags: nil,
+		},
+		{
+			name:         "load description from attribute",
+			description:  "The quick brown fox\n",
+			lineNumber:   1,
+			expected:     "The quick brown fox\n",
+			expectedTags: nil,
+		},
+		{
+			name:         "load description and tags from attribute",
+			description:  "The quick brown fox\n\n@since 1.0.0\n",
+			lineNumber:   1,
+			expected:     "The quick brown fox\n",
+			expectedTags: tfconf.Tags{"since": "1.0.0"},
+		},
+		{
+			name:         "load description with only tags from attribute",
+			description:  "@deprecated",
+			lineNumber:   1,
+			expected:     "",
+			expectedTags: tfconf.Tags{"deprecated": ""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			description, tags := loadDescription(tt.description, filepath.Join("testdata", "full-example", "variables.tf"), tt.lineNumber)
+			assert.Equal(tt.expected, description)
+			assert.Equal(tt.expectedTags, tags)
+		})
+	}
+}
+
 func TestSortItems(t *testing.T) {
 	type expected struct {
 		inputs    []string
@@ -564,6 +1235,8 @@ func TestSortItems(t *testing.T) {
 		optional  []string
 		outputs   []string
 		providers []string
+		modules   []string
+		resources []string
 	}
 	tests := []struct {
 		name     string
@@ -580,7 +1253,9 @@ func TestSortItems(t *testing.T) {
 				required:  []string{"A", "F"},
 				optional:  []string{"D", "B", "E", "C", "G"},
 				outputs:   []string{"C", "A", "B"},
-				providers: []string{"tls", "aws", "null"},
+				providers: []string{"aws.east", "aws.replica", "tls", "aws", "null"},
+				modules:   []string{"foo", "bar", "baz"},
+				resources: []string{"tls_private_key.baz", "data.aws_caller_identity.current", "null_resource.foo"},
 			},
 		},
 		{
@@ -592,7 +1267,9 @@ func TestSortItems(t *testing.T) {
 				required:  []string{"A", "F"},
 				optional:  []string{"B", "C", "D", "E", "G"},
 				outputs:   []string{"A", "B", "C"},
-				providers: []string{"aws", "null", "tls"},
+				providers: []string{"aws", "aws.east", "aws.replica", "null", "tls"},
+				modules:   []string{"bar", "baz", "foo"},
+				resources: []string{"data.aws_caller_identity.current", "null_resource.foo", "tls_private_key.baz"},
 			},
 		},
 		{
@@ -604,7 +1281,9 @@ func TestSortItems(t *testing.T) {
 				required:  []string{"A", "F"},
 				optional:  []string{"D", "B", "E", "C", "G"},
 				outputs:   []string{"C", "A", "B"},
-				providers: []string{"tls", "aws", "null"},
+				providers: []string{"aws.east", "aws.replica", "tls", "aws", "null"},
+				modules:   []string{"foo", "bar", "baz"},
+				resources: []string{"tls_private_key.baz", "data.aws_caller_identity.current", "null_resource.foo"},
 			},
 		},
 		{
@@ -616,7 +1295,9 @@ func TestSortItems(t *testing.T) {
 				required:  []string{"A", "F"},
 				optional:  []string{"G", "B", "C", "D", "E"},
 				outputs:   []string{"A", "B", "C"},
-				providers: []string{"aws", "null", "tls"},
+				providers: []string{"aws", "aws.east", "aws.replica", "null", "tls"},
+				modules:   []string{"bar", "baz", "foo"},
+				resources: []string{"data.aws_caller_identity.current", "null_resource.foo", "tls_private_key.baz"},
 			},
 		},
 		{
@@ -628,7 +1309,9 @@ func TestSortItems(t *testing.T) {
 				required:  []string{"A", "F"},
 				optional:  []string{"B", "C", "D", "E", "G"},
 				outputs:   []string{"A", "B", "C"},
-				providers: []string{"aws", "null", "tls"},
+				providers: []string{"aws", "aws.east", "aws.replica", "null", "tls"},
+				modules:   []string{"bar", "baz", "foo"},
+				resources: []string{"data.aws_caller_identity.current", "null_resource.foo", "tls_private_key.baz"},
 			},
 		},
 		{
@@ -640,7 +1323,9 @@ func TestSortItems(t *testing.T) {
 				required:  []string{"A", "F"},
 				optional:  []string{"G", "B", "C", "D", "E"},
 				outputs:   []string{"A", "B", "C"},
-				providers: []string{"aws", "null", "tls"},
+				providers: []string{"aws", "aws.east", "aws.replica", "null", "tls"},
+				modules:   []string{"bar", "baz", "foo"},
+				resources: []string{"data.aws_caller_identity.current", "null_resource.foo", "tls_private_key.baz"},
 			},
 		},
 		{
@@ -652,7 +1337,9 @@ func TestSortItems(t *testing.T) {
 				required:  []string{"A", "F"},
 				optional:  []string{"G", "B", "C", "D", "E"},
 				outputs:   []string{"A", "B", "C"},
-				providers: []string{"aws", "null", "tls"},
+				providers: []string{"aws", "aws.east", "aws.replica", "null", "tls"},
+				modules:   []string{"bar", "baz", "foo"},
+				resources: []string{"data.aws_caller_identity.current", "null_resource.foo", "tls_private_key.baz"},
 			},
 		},
 		{
@@ -664,7 +1351,9 @@ func TestSortItems(t *testing.T) {
 				required:  []string{"A", "F"},
 				optional:  []string{"G", "B", "C", "D", "E"},
 				outputs:   []string{"A", "B", "C"},
-				providers: []string{"aws", "null", "tls"},
+				providers: []string{"aws", "aws.east", "aws.replica", "null", "tls"},
+				modules:   []string{"bar", "baz", "foo"},
+				resources: []string{"data.aws_caller_identity.current", "null_resource.foo", "tls_private_key.baz"},
 			},
 		},
 	}
@@ -695,7 +1384,13 @@ func TestSortItems(t *testing.T) {
 				assert.Equal(tt.expected.outputs[i], v.Name)
 			}
 			for i, v := range module.Providers {
-				assert.Equal(tt.expected.providers[i], v.Name)
+				assert.Equal(tt.expected.providers[i], v.FullName())
+			}
+			for i, v := range module.Modules {
+				assert.Equal(tt.expected.modules[i], v.Name)
+			}
+			for i, v := range module.Resources {
+				assert.Equal(tt.expected.resources[i], v.Address)
 			}
 		})
 	}
@@ -2,6 +2,7 @@ package module
 
 import (
 	"errors"
+	"io"
 
 	"github.com/imdario/mergo"
 )
@@ -19,9 +20,17 @@ type Options struct {
 	Path             string
 	ShowHeader       bool
 	HeaderFromFile   string
+	HeaderStopAt     string
+	ShowFooter       bool
+	FooterFromFile   string
 	SortBy           *SortBy
 	OutputValues     bool
 	OutputValuesPath string
+	ResourceLink     string
+	ProviderLink     string
+	Strict           bool
+	Verbose          bool
+	Diagnostics      io.Writer
 }
 
 // NewOptions returns new instance of Options
@@ -30,9 +39,17 @@ func NewOptions() *Options {
 		Path:             "",
 		ShowHeader:       true,
 		HeaderFromFile:   "main.tf",
+		HeaderStopAt:     "blank-line",
+		ShowFooter:       true,
+		FooterFromFile:   "",
 		SortBy:           &SortBy{Name: false, Required: false, Type: false},
 		OutputValues:     false,
 		OutputValuesPath: "",
+		ResourceLink:     "",
+		ProviderLink:     "",
+		Strict:           false,
+		Verbose:          false,
+		Diagnostics:      nil,
 	}
 }
 
@@ -0,0 +1,24 @@
+package module
+
+import (
+	"github.com/terraform-docs/terraform-docs/pkg/tfconf"
+)
+
+type resourcesSortedByAddress []*tfconf.Resource
+
+func (a resourcesSortedByAddress) Len() int      { return len(a) }
+func (a resourcesSortedByAddress) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a resourcesSortedByAddress) Less(i, j int) bool {
+	return a[i].Address < a[j].Address
+}
+
+type resourcesSortedByPosition []*tfconf.Resource
+
+func (a resourcesSortedByPosition) Len() int      { return len(a) }
+func (a resourcesSortedByPosition) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a resourcesSortedByPosition) Less(i, j int) bool {
+	if a[i].Position.Filename == a[j].Position.Filename {
+		return a[i].Position.Line < a[j].Position.Line
+	}
+	return a[i].Position.Filename < a[j].Position.Filename
+}
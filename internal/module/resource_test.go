@@ -0,0 +1,75 @@
+package module
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/pkg/tfconf"
+)
+
+func TestResourcesSortedByAddress(t *testing.T) {
+	assert := assert.New(t)
+	resources := sampleResources()
+
+	sort.Sort(resourcesSortedByAddress(resources))
+
+	expected := []string{"a_foo.bar", "b_foo.baz", "data.a_foo.bar", "data.c_foo.bar"}
+	actual := make([]string, len(resources))
+
+	for k, r := range resources {
+		actual[k] = r.Address
+	}
+
+	assert.Equal(expected, actual)
+}
+
+func TestResourcesSortedByPosition(t *testing.T) {
+	assert := assert.New(t)
+	resources := sampleResources()
+
+	sort.Sort(resourcesSortedByPosition(resources))
+
+	expected := []string{"data.a_foo.bar", "data.c_foo.bar", "b_foo.baz", "a_foo.bar"}
+	actual := make([]string, len(resources))
+
+	for k, r := range resources {
+		actual[k] = r.Address
+	}
+
+	assert.Equal(expected, actual)
+}
+
+func sampleResources() []*tfconf.Resource {
+	return []*tfconf.Resource{
+		{
+			Address:  "b_foo.baz",
+			Type:     "b_foo",
+			Mode:     "managed",
+			Provider: "b",
+			Position: tfconf.Position{Filename: "foo/main.tf", Line: 21},
+		},
+		{
+			Address:  "data.c_foo.bar",
+			Type:     "c_foo",
+			Mode:     "data",
+			Provider: "c",
+			Position: tfconf.Position{Filename: "foo/data.tf", Line: 3},
+		},
+		{
+			Address:  "a_foo.bar",
+			Type:     "a_foo",
+			Mode:     "managed",
+			Provider: "a.alias",
+			Position: tfconf.Position{Filename: "foo/main.tf", Line: 39},
+		},
+		{
+			Address:  "data.a_foo.bar",
+			Type:     "a_foo",
+			Mode:     "data",
+			Provider: "a",
+			Position: tfconf.Position{Filename: "foo/data.tf", Line: 1},
+		},
+	}
+}
@@ -11,10 +11,21 @@
 terraform {
   required_version = ">= 0.12"
   required_providers {
-    aws = ">= 2.15.0"
+    aws = {
+      source                = "hashicorp/aws"
+      version               = ">= 2.15.0"
+      configuration_aliases = [aws.east]
+    }
+    tls = {
+      source = "example.com/foo/tls"
+    }
   }
 }
 
+provider "aws" {
+  alias = "replica"
+}
+
 resource "tls_private_key" "baz" {}
 
 data "aws_caller_identity" "current" {
@@ -22,3 +33,26 @@ data "aws_caller_identity" "current" {
 }
 
 resource "null_resource" "foo" {}
+
+module "foo" {
+  source  = "bar"
+  version = "1.2.3"
+}
+
+module "bar" {
+  source  = "baz"
+  version = "4.5.6"
+}
+
+module "baz" {
+  source = "./modules/baz"
+}
+
+locals {
+  # Name prefix of all the resources
+  prefix = "foo"
+
+  tags = {
+    Name = local.prefix
+  }
+}
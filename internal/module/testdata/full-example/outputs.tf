@@ -6,6 +6,7 @@ output C {
 output "A" {
   description = "A description"
   value       = "a"
+  sensitive   = true
 }
 
 // B description
@@ -22,9 +22,15 @@ variable "C" {
 
 variable "F" {
   description = "F description"
+
+  validation {
+    condition     = length(var.F) > 0
+    error_message = "F can't be empty."
+  }
 }
 
 variable "G" {
   description = "G description"
   default     = null
+  sensitive   = true
 }
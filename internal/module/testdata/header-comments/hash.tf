@@ -0,0 +1,9 @@
+# Custom Header:
+#
+# Example of 'foo_bar' module in `foo_bar.tf`.
+#
+# - list item 1
+# - list item 2
+
+# Comment of the resource, not part of the header
+resource "null_resource" "foo" {}
@@ -0,0 +1,7 @@
+// Custom Header:
+//
+// Example of 'foo_bar' module in `foo_bar.tf`.
+//
+// - list item 1
+// - list item 2
+resource "null_resource" "foo" {}
@@ -0,0 +1,5 @@
+variable "unquoted"
+{
+  description = "The opening brace is only valid on its own line in HCL 1."
+  default     = "foo"
+}
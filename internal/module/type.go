@@ -0,0 +1,203 @@
+package module
+
+import (
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/terraform-docs/terraform-docs/internal/types"
+	"github.com/terraform-docs/terraform-docs/pkg/tfconf"
+)
+
+// loadType parses the raw source of a type constraint into its structure.
+// It returns nil if the source is not a valid type expression.
+func loadType(source string) *tfconf.Type {
+	src := []byte(source)
+	start := hcl.Pos{Line: 1, Column: 1}
+
+	expr, diags := hclsyntax.ParseExpression(src, "", start)
+	if diags.HasErrors() {
+		return nil
+	}
+	tokens, _ := hclsyntax.LexExpression(src, "", start)
+
+	p := &typeParser{
+		source: src,
+		tokens: tokens,
+	}
+	return p.typeOf(expr)
+}
+
+type typeParser struct {
+	source []byte
+	tokens hclsyntax.Tokens
+}
+
+func (p *typeParser) typeOf(expr hcl.Expression) *tfconf.Type {
+	switch e := expr.(type) {
+	case *hclsyntax.ScopeTraversalExpr:
+		// primitive types, e.g. 'string', or collection types without
+		// element type, e.g. 'list'
+		if len(e.Traversal) != 1 {
+			return nil
+		}
+		return &tfconf.Type{Kind: e.Traversal.RootName()}
+
+	case *hclsyntax.TemplateExpr:
+		// quoted types of Terraform 0.11, e.g. "string"
+		if !e.IsStringLiteral() {
+			return nil
+		}
+		val, diags := e.Value(nil)
+		if diags.HasErrors() {
+			return nil
+		}
+		return &tfconf.Type{Kind: val.AsString()}
+
+	case *hclsyntax.FunctionCallExpr:
+		if len(e.Args) != 1 {
+			return nil
+		}
+		switch e.Name {
+		case "list", "set", "map":
+			element := p.typeOf(e.Args[0])
+			if element == nil {
+				return nil
+			}
+			return &tfconf.Type{Kind: e.Name, Element: element}
+		case "tuple":
+			tuple, ok := e.Args[0].(*hclsyntax.TupleConsExpr)
+			if !ok {
+				return nil
+			}
+			t := &tfconf.Type{Kind: e.Name}
+			for _, item := range tuple.Exprs {
+				element := p.typeOf(item)
+				if element == nil {
+					return nil
+				}
+				t.Elements = append(t.Elements, element)
+			}
+			return t
+		case "object":
+			object, ok := e.Args[0].(*hclsyntax.ObjectConsExpr)
+			if !ok {
+				return nil
+			}
+			t := &tfconf.Type{Kind: e.Name}
+			for _, item := range object.Items {
+				attribute := p.attributeOf(item)
+				if attribute == nil {
+					return nil
+				}
+				t.Attributes = append(t.Attributes, attribute)
+			}
+			return t
+		}
+	}
+	return nil
+}
+
+func (p *typeParser) attributeOf(item hclsyntax.ObjectConsItem) *tfconf.TypeAttribute {
+	name := attributeName(item.KeyExpr)
+	if name == "" {
+		return nil
+	}
+	attribute := &tfconf.TypeAttribute{
+		Name:        name,
+		Description: types.String(p.commentsOf(item)),
+	}
+
+	expr := item.ValueExpr
+	if call, ok := expr.(*hclsyntax.FunctionCallExpr); ok && call.Name == "optional" {
+		// optional(type) or optional(type, default) of Terraform 0.14+
+		if len(call.Args) < 1 || len(call.Args) > 2 {
+			return nil
+		}
+		attribute.Optional = true
+		if len(call.Args) == 2 {
+			attribute.Default = string(call.Args[1].Range().SliceBytes(p.source))
+		}
+		expr = call.Args[0]
+	}
+
+	if attribute.Type = p.typeOf(expr); attribute.Type == nil {
+		return nil
+	}
+	return attribute
+}
+
+// commentsOf returns the comments attached to an attribute of object type,
+// either the trailing comment on the same line as the attribute ends, e.g.
+//
+//	name = string # name of the resource
+//
+// or the comment lines immediately above the attribute.
+func (p *typeParser) commentsOf(item hclsyntax.ObjectConsItem) string {
+	first, last := -1, -1
+	for i, token := range p.tokens {
+		if first == -1 && token.Range.Start.Byte >= item.KeyExpr.Range().Start.Byte {
+			first = i
+		}
+		if token.Range.Start.Byte >= item.ValueExpr.Range().End.Byte {
+			last = i
+			break
+		}
+	}
+	if first == -1 || last == -1 {
+		return ""
+	}
+
+	// trailing comment, optionally preceded by the separating comma
+	for i := last; i < len(p.tokens); i++ {
+		token := p.tokens[i]
+		if token.Type == hclsyntax.TokenComma {
+			continue
+		}
+		if token.Type == hclsyntax.TokenComment && token.Range.Start.Line == item.ValueExpr.Range().End.Line {
+			return commentText(token.Bytes)
+		}
+		break
+	}
+
+	// leading comments, each on their own line
+	lines := []string{}
+	for i := first - 1; i >= 0; i-- {
+		token := p.tokens[i]
+		if token.Type != hclsyntax.TokenComment {
+			break
+		}
+		if i > 0 {
+			prev := p.tokens[i-1]
+			if prev.Type != hclsyntax.TokenComment && prev.Type != hclsyntax.TokenNewline && prev.Range.End.Line == token.Range.Start.Line {
+				break
+			}
+		}
+		lines = append([]string{commentText(token.Bytes)}, lines...)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func commentText(comment []byte) string {
+	text := strings.TrimSpace(string(comment))
+	for _, prefix := range []string{"#", "//"} {
+		if strings.HasPrefix(text, prefix) {
+			return strings.TrimSpace(strings.TrimPrefix(text, prefix))
+		}
+	}
+	text = strings.TrimSuffix(strings.TrimPrefix(text, "/*"), "*/")
+	return strings.TrimSpace(text)
+}
+
+func attributeName(expr hcl.Expression) string {
+	if name := hcl.ExprAsKeyword(expr); name != "" {
+		return name
+	}
+	val, diags := expr.Value(nil)
+	if diags.HasErrors() || val.IsNull() || !val.IsKnown() || val.Type() != cty.String {
+		return ""
+	}
+	return val.AsString()
+}
@@ -0,0 +1,108 @@
+package module
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-docs/terraform-docs/pkg/tfconf"
+)
+
+func TestLoadType(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		expected *tfconf.Type
+	}{
+		{
+			name:     "primitive type",
+			source:   "string",
+			expected: &tfconf.Type{Kind: "string"},
+		},
+		{
+			name:     "quoted type",
+			source:   `"list"`,
+			expected: &tfconf.Type{Kind: "list"},
+		},
+		{
+			name:     "collection type",
+			source:   "map(number)",
+			expected: &tfconf.Type{Kind: "map", Element: &tfconf.Type{Kind: "number"}},
+		},
+		{
+			name:   "tuple type",
+			source: "tuple([string, bool])",
+			expected: &tfconf.Type{
+				Kind:     "tuple",
+				Elements: []*tfconf.Type{{Kind: "string"}, {Kind: "bool"}},
+			},
+		},
+		{
+			name: "object type",
+			source: `list(object({
+				name  = string,
+				"key" = optional(set(string))
+				rule  = object({ port = number })
+			}))`,
+			expected: &tfconf.Type{
+				Kind: "list",
+				Element: &tfconf.Type{
+					Kind: "object",
+					Attributes: []*tfconf.TypeAttribute{
+						{Name: "name", Type: &tfconf.Type{Kind: "string"}},
+						{Name: "key", Type: &tfconf.Type{Kind: "set", Element: &tfconf.Type{Kind: "string"}}, Optional: true},
+						{Name: "rule", Type: &tfconf.Type{
+							Kind: "object",
+							Attributes: []*tfconf.TypeAttribute{
+								{Name: "port", Type: &tfconf.Type{Kind: "number"}},
+							},
+						}},
+					},
+				},
+			},
+		},
+		{
+			name: "object type with comments and defaults",
+			source: `object({
+				name = string # name of the rule
+				port = optional(number, 80), // port of the rule
+				# list of protocols,
+				# the rule applies to
+				protocols = optional(list(string), ["tcp", "udp"])
+				tags = object({ env = string }) /* tags of the rule */
+			})`,
+			expected: &tfconf.Type{
+				Kind: "object",
+				Attributes: []*tfconf.TypeAttribute{
+					{Name: "name", Type: &tfconf.Type{Kind: "string"}, Description: "name of the rule"},
+					{Name: "port", Type: &tfconf.Type{Kind: "number"}, Description: "port of the rule", Optional: true, Default: "80"},
+					{Name: "protocols", Type: &tfconf.Type{Kind: "list", Element: &tfconf.Type{Kind: "string"}}, Description: "list of protocols,\nthe rule applies to", Optional: true, Default: `["tcp", "udp"]`},
+					{Name: "tags", Type: &tfconf.Type{
+						Kind: "object",
+						Attributes: []*tfconf.TypeAttribute{
+							{Name: "env", Type: &tfconf.Type{Kind: "string"}},
+						},
+					}, Description: "tags of the rule"},
+				},
+			},
+		},
+		{
+			name:     "invalid expression",
+			source:   "object({",
+			expected: nil,
+		},
+		{
+			name:     "invalid type",
+			source:   "list(string, number)",
+			expected: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			actual := loadType(tt.source)
+
+			assert.Equal(tt.expected, actual)
+		})
+	}
+}
@@ -40,15 +40,14 @@ func (l *Lines) extract(r io.Reader) ([]string, error) {
 		}
 		line, err := bf.ReadString('\n')
 		if err == io.EOF && line == "" {
-			switch lnum {
-			case 0:
+			switch {
+			case lnum == 0:
 				return nil, errors.New("no lines in file")
-			case 1:
+			case l.LineNum == -1:
+				return lines, nil
+			case lnum == 1:
 				return nil, errors.New("only 1 line")
 			default:
-				if l.LineNum == -1 {
-					break
-				}
 				return nil, fmt.Errorf("only %d lines", lnum)
 			}
 		}
@@ -12,6 +12,8 @@ const textEmpty = ``
 
 const textOneLine = `Lorem ipsum dolor sit amet`
 
+const textOneLineComment = `# Lorem ipsum dolor sit amet`
+
 const textWithLeadingComment = `
 /**
  * Morbi vitae nulla in dui lobortis
@@ -169,6 +171,14 @@ func TestReadLinesFromText(t *testing.T) {
 			wantError:   true,
 			errorText:   "only 1 line",
 		},
+		{
+			name:        "extract lines from text",
+			textContent: textOneLineComment,
+			lineNumber:  -1,
+			expected:    "Lorem ipsum dolor sit amet",
+			wantError:   false,
+			errorText:   "",
+		},
 		{
 			name:        "extract lines from text",
 			textContent: textWithoutLeadingComment,
@@ -40,14 +40,17 @@ func (s *TestSettings) WithColor() *TestSettings {
 	return s
 }
 
-// WithSections appends predefined show all sections ShowHeader, ShowProviders, ShowInputs, ShowOutputs to TestSettings
+// WithSections appends predefined show all sections ShowFooter, ShowHeader, ShowProviders, ShowModules, ShowResources, ShowInputs, ShowOutputs to TestSettings
 func (s *TestSettings) WithSections() *TestSettings {
 	sections := &print.Settings{
+		ShowFooter:       true,
 		ShowHeader:       true,
 		ShowInputs:       true,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    true,
 		ShowRequirements: true,
+		ShowResources:    true,
 	}
 	if err := mergo.Merge(sections, s.full); err == nil {
 		s.full = sections
@@ -103,6 +103,7 @@ func diagnosticsHCL(diags hcl.Diagnostics) Diagnostics {
 		}
 		if diag.Subject != nil {
 			pos := sourcePosHCL(*diag.Subject)
+			pos.Column = diag.Subject.Start.Column
 			ret[i].Pos = &pos
 		}
 	}
@@ -116,6 +117,7 @@ func diagnosticsError(err error) Diagnostics {
 
 	if posErr, ok := err.(*legacyhclparser.PosError); ok {
 		pos := sourcePosLegacyHCL(posErr.Pos, "")
+		pos.Column = posErr.Pos.Column
 		return Diagnostics{
 			Diagnostic{
 				Severity: DiagError,
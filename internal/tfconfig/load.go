@@ -25,6 +25,9 @@ func LoadModule(dir string) (*Module, Diagnostics) {
 		// Try using the legacy HCL parser and see if we fare better.
 		legacyModule, legacyDiags := loadModuleLegacyHCL(dir)
 		if !legacyDiags.HasErrors() {
+			// Let the caller know explicitly that the module is loaded with
+			// the legacy parser, along with the reason that made us do so.
+			legacyDiags = append(legacyDiags, legacyNotice(diags))
 			legacyModule.init(legacyDiags)
 			return legacyModule, legacyDiags
 		}
@@ -34,6 +37,28 @@ func LoadModule(dir string) (*Module, Diagnostics) {
 	return module, diags
 }
 
+// legacyNotice returns a warning Diagnostic describing that the module has
+// been loaded with the legacy HCL parser because of the first error in diags.
+func legacyNotice(diags Diagnostics) Diagnostic {
+	notice := Diagnostic{
+		Severity: DiagWarning,
+		Summary:  "Module loaded with legacy HCL parser",
+		Detail:   "The module is not valid in HCL 2 syntax and is loaded with the legacy HCL parser instead, which may give incomplete results.",
+	}
+	for _, diag := range diags {
+		if diag.Severity != DiagError {
+			continue
+		}
+		notice.Detail = fmt.Sprintf("%s The first error found was: %s", notice.Detail, diag.Summary)
+		if diag.Detail != "" {
+			notice.Detail = fmt.Sprintf("%s: %s", notice.Detail, diag.Detail)
+		}
+		notice.Pos = diag.Pos
+		break
+	}
+	return notice
+}
+
 // IsModuleDir checks if the given path contains terraform configuration files.
 // This allows the caller to decide how to handle directories that do not have tf files.
 func IsModuleDir(dir string) bool {
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/gohcl"
 	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/zclconf/go-cty/cty"
 	ctyjson "github.com/zclconf/go-cty/cty/json"
 )
 
@@ -60,7 +61,11 @@ func loadModule(dir string) (*Module, Diagnostics) {
 							if _, exists := mod.RequiredProviders[name]; !exists {
 								mod.RequiredProviders[name] = req
 							} else {
+								if mod.RequiredProviders[name].Source == "" {
+									mod.RequiredProviders[name].Source = req.Source
+								}
 								mod.RequiredProviders[name].VersionConstraints = append(mod.RequiredProviders[name].VersionConstraints, req.VersionConstraints...)
+								mod.RequiredProviders[name].ConfigurationAliases = append(mod.RequiredProviders[name].ConfigurationAliases, req.ConfigurationAliases...)
 							}
 						}
 					}
@@ -127,6 +132,13 @@ func loadModule(dir string) (*Module, Diagnostics) {
 				}
 
 				if attr, defined := content.Attributes["default"]; defined {
+					// We also keep the raw source of the default value, since
+					// the conversion below may be lossy.
+					rng := attr.Expr.Range()
+					if source, exists := parser.Sources()[rng.Filename]; exists {
+						v.DefaultSource = string(rng.SliceBytes(source))
+					}
+
 					// To avoid the caller needing to deal with cty here, we'll
 					// use its JSON encoding to convert into an
 					// approximately-equivalent plain Go interface{} value
@@ -153,6 +165,59 @@ func loadModule(dir string) (*Module, Diagnostics) {
 					v.Required = true
 				}
 
+				if attr, defined := content.Attributes["sensitive"]; defined {
+					var sensitive bool
+					valDiags := gohcl.DecodeExpression(attr.Expr, nil, &sensitive)
+					diags = append(diags, valDiags...)
+					v.Sensitive = sensitive
+				}
+
+				if attr, defined := content.Attributes["nullable"]; defined {
+					var nullable bool
+					valDiags := gohcl.DecodeExpression(attr.Expr, nil, &nullable)
+					diags = append(diags, valDiags...)
+					v.Nullable = &nullable
+				}
+
+				for _, validation := range content.Blocks {
+					if validation.Type != "validation" {
+						continue
+					}
+
+					vc, vcDiags := validation.Body.Content(variableValidationSchema)
+					diags = append(diags, vcDiags...)
+
+					vv := &VariableValidation{
+						Pos: sourcePosHCL(validation.DefRange),
+					}
+
+					if attr, defined := vc.Attributes["condition"]; defined {
+						// The condition refers to the variable itself, so it
+						// can't be evaluated here. We keep its raw source instead.
+						rng := attr.Expr.Range()
+						if source, exists := parser.Sources()[rng.Filename]; exists {
+							vv.Condition = string(rng.SliceBytes(source))
+						}
+					}
+
+					if attr, defined := vc.Attributes["error_message"]; defined {
+						// The message may refer to variables or call functions
+						// too, in which case its raw source is kept as is.
+						// It's only documented, so its diagnostics are ignored.
+						value, valDiags := attr.Expr.Value(nil)
+						if !valDiags.HasErrors() && value.Type() == cty.String && value.IsKnown() && !value.IsNull() {
+							vv.ErrorMessage = value.AsString()
+						} else {
+							rng := attr.Expr.Range()
+							if source, exists := parser.Sources()[rng.Filename]; exists {
+								vv.ErrorMessage = string(rng.SliceBytes(source))
+							}
+						}
+					}
+
+					v.Validations = append(v.Validations, vv)
+				}
+
 			case "output":
 
 				content, _, contentDiags := block.Body.PartialContent(outputSchema)
@@ -173,6 +238,33 @@ func loadModule(dir string) (*Module, Diagnostics) {
 					o.Description = description
 				}
 
+				if attr, defined := content.Attributes["sensitive"]; defined {
+					var sensitive bool
+					valDiags := gohcl.DecodeExpression(attr.Expr, nil, &sensitive)
+					diags = append(diags, valDiags...)
+					o.Sensitive = sensitive
+				}
+
+			case "locals":
+
+				attrs, attrsDiags := block.Body.JustAttributes()
+				diags = append(diags, attrsDiags...)
+
+				for name, attr := range attrs {
+					l := &Local{
+						Name: name,
+						Pos:  sourcePosHCL(attr.Range),
+					}
+					// Similar to the type of variables, the raw source of
+					// the expression is kept as is, since it can't be
+					// evaluated without the rest of the configuration.
+					rng := attr.Expr.Range()
+					if source, exists := parser.Sources()[rng.Filename]; exists {
+						l.Expression = string(rng.SliceBytes(source))
+					}
+					mod.Locals[name] = l
+				}
+
 			case "provider":
 
 				content, _, contentDiags := block.Body.PartialContent(providerConfigSchema)
@@ -193,6 +285,16 @@ func loadModule(dir string) (*Module, Diagnostics) {
 					}
 				}
 
+				pc := &ProviderConfig{
+					Name: name,
+					Pos:  sourcePosHCL(block.DefRange),
+				}
+				if attr, defined := content.Attributes["alias"]; defined {
+					valDiags := gohcl.DecodeExpression(attr.Expr, nil, &pc.Alias)
+					diags = append(diags, valDiags...)
+				}
+				mod.ProviderConfigs[pc.MapKey()] = pc
+
 			case "resource", "data":
 
 				content, _, contentDiags := block.Body.PartialContent(resourceSchema)
@@ -252,6 +252,7 @@ func loadModuleLegacyHCL(dir string) (*Module, Diagnostics) {
 			providerConfigs = providerConfigs.Children()
 			type ProviderBlock struct {
 				Version string
+				Alias   string
 			}
 
 			for _, item := range providerConfigs.Items {
@@ -277,6 +278,13 @@ func loadModuleLegacyHCL(dir string) (*Module, Diagnostics) {
 				if block.Version != "" {
 					mod.RequiredProviders[name].VersionConstraints = append(mod.RequiredProviders[name].VersionConstraints, block.Version)
 				}
+
+				pc := &ProviderConfig{
+					Name:  name,
+					Alias: block.Alias,
+					Pos:   sourcePosLegacyHCL(item.Pos(), filename),
+				}
+				mod.ProviderConfigs[pc.MapKey()] = pc
 			}
 		}
 	}
@@ -0,0 +1,13 @@
+package tfconfig
+
+// Local represents a single local value declared in a "locals" block of a
+// Terraform module.
+type Local struct {
+	Name string `json:"name"`
+
+	// Expression is the raw source of the value expression, as written in
+	// the configuration.
+	Expression string `json:"expression"`
+
+	Pos SourcePos `json:"pos"`
+}
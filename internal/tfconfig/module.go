@@ -8,9 +8,11 @@ type Module struct {
 
 	Variables map[string]*Variable `json:"variables"`
 	Outputs   map[string]*Output   `json:"outputs"`
+	Locals    map[string]*Local    `json:"locals,omitempty"`
 
 	RequiredCore      []string                        `json:"required_core,omitempty"`
 	RequiredProviders map[string]*ProviderRequirement `json:"required_providers"`
+	ProviderConfigs   map[string]*ProviderConfig      `json:"provider_configs,omitempty"`
 
 	ManagedResources map[string]*Resource   `json:"managed_resources"`
 	DataResources    map[string]*Resource   `json:"data_resources"`
@@ -27,7 +29,9 @@ func newModule(path string) *Module {
 		Path:              path,
 		Variables:         make(map[string]*Variable),
 		Outputs:           make(map[string]*Output),
+		Locals:            make(map[string]*Local),
 		RequiredProviders: make(map[string]*ProviderRequirement),
+		ProviderConfigs:   make(map[string]*ProviderConfig),
 		ManagedResources:  make(map[string]*Resource),
 		DataResources:     make(map[string]*Resource),
 		ModuleCalls:       make(map[string]*ModuleCall),
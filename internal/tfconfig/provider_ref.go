@@ -1,6 +1,8 @@
 package tfconfig
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/hcl/v2"
 	"github.com/hashicorp/hcl/v2/gohcl"
 	"github.com/zclconf/go-cty/cty/gocty"
@@ -17,19 +19,49 @@ type ProviderRef struct {
 type ProviderRequirement struct {
 	Source             string   `json:"source,omitempty"`
 	VersionConstraints []string `json:"version_constraints,omitempty"`
+
+	// ConfigurationAliases are the provider configurations which the module
+	// expects to be passed in by its callers.
+	ConfigurationAliases []ProviderRef `json:"configuration_aliases,omitempty"`
+}
+
+// ProviderConfig represents a provider configuration block declared within
+// a module.
+type ProviderConfig struct {
+	Name  string    `json:"name"`
+	Alias string    `json:"alias,omitempty"`
+	Pos   SourcePos `json:"pos"`
+}
+
+// MapKey returns a string that can be used to uniquely identify the provider
+// configuration within its module, e.g. "aws" or "aws.replica".
+func (p *ProviderConfig) MapKey() string {
+	if p.Alias != "" {
+		return fmt.Sprintf("%s.%s", p.Name, p.Alias)
+	}
+	return p.Name
 }
 
 func decodeRequiredProvidersBlock(block *hcl.Block) (map[string]*ProviderRequirement, hcl.Diagnostics) {
 	attrs, diags := block.Body.JustAttributes()
 	reqs := make(map[string]*ProviderRequirement)
 	for name, attr := range attrs {
+		// The object form is decoded attribute by attribute, because
+		// 'configuration_aliases' contains references to provider
+		// configurations which can't be evaluated as values.
+		if kvs, mapDiags := hcl.ExprMap(attr.Expr); !mapDiags.HasErrors() {
+			pr, prDiags := decodeProviderRequirement(kvs)
+			diags = append(diags, prDiags...)
+			reqs[name] = pr
+			continue
+		}
+
 		expr, err := attr.Expr.Value(nil)
 		if err != nil {
 			diags = append(diags, err...)
 		}
 
-		switch {
-		case expr.Type().IsPrimitiveType():
+		if expr.Type().IsPrimitiveType() {
 			var version string
 			valDiags := gohcl.DecodeExpression(attr.Expr, nil, &version)
 			diags = append(diags, valDiags...)
@@ -38,48 +70,84 @@ func decodeRequiredProvidersBlock(block *hcl.Block) (map[string]*ProviderRequire
 					VersionConstraints: []string{version},
 				}
 			}
+			continue
+		}
 
-		case expr.Type().IsObjectType():
-			var pr ProviderRequirement
-			if expr.Type().HasAttribute("version") {
-				var version string
-				err := gocty.FromCtyValue(expr.GetAttr("version"), &version)
-				if err == nil {
-					pr.VersionConstraints = append(pr.VersionConstraints, version)
-				} else {
-					diags = append(diags, &hcl.Diagnostic{
-						Severity: hcl.DiagError,
-						Summary:  "Unsuitable value type",
-						Detail:   "Unsuitable value: string required",
-						Subject:  attr.Expr.Range().Ptr(),
-					})
-				}
+		diags = append(diags, unsuitableValueDiagnostic(attr.Expr))
+	}
+
+	return reqs, diags
+}
+
+func decodeProviderRequirement(kvs []hcl.KeyValuePair) (*ProviderRequirement, hcl.Diagnostics) {
+	var diags hcl.Diagnostics
+	var pr ProviderRequirement
+	for _, kv := range kvs {
+		key := hcl.ExprAsKeyword(kv.Key)
+		if key == "" {
+			if err := gohcl.DecodeExpression(kv.Key, nil, &key); err.HasErrors() {
+				diags = append(diags, err...)
+				continue
 			}
-			if expr.Type().HasAttribute("source") {
-				var source string
-				err := gocty.FromCtyValue(expr.GetAttr("source"), &source)
-				if err == nil {
-					pr.Source = source
-				} else {
-					diags = append(diags, &hcl.Diagnostic{
-						Severity: hcl.DiagError,
-						Summary:  "Unsuitable value type",
-						Detail:   "Unsuitable value: string required",
-						Subject:  attr.Expr.Range().Ptr(),
-					})
+		}
+
+		switch key {
+		case "version":
+			var version string
+			if err := decodeString(kv.Value, &version); err != nil {
+				diags = append(diags, err)
+				continue
+			}
+			pr.VersionConstraints = append(pr.VersionConstraints, version)
+
+		case "source":
+			if err := decodeString(kv.Value, &pr.Source); err != nil {
+				diags = append(diags, err)
+			}
+
+		case "configuration_aliases":
+			exprs, listDiags := hcl.ExprList(kv.Value)
+			if listDiags.HasErrors() {
+				diags = append(diags, listDiags...)
+				continue
+			}
+			for _, expr := range exprs {
+				traversal, travDiags := hcl.AbsTraversalForExpr(expr)
+				if travDiags.HasErrors() {
+					diags = append(diags, travDiags...)
+					continue
+				}
+				ref := ProviderRef{
+					Name: traversal.RootName(),
 				}
+				if len(traversal) > 1 {
+					if attr, ok := traversal[1].(hcl.TraverseAttr); ok {
+						ref.Alias = attr.Name
+					}
+				}
+				pr.ConfigurationAliases = append(pr.ConfigurationAliases, ref)
 			}
-			reqs[name] = &pr
-
-		default:
-			diags = append(diags, &hcl.Diagnostic{
-				Severity: hcl.DiagError,
-				Summary:  "Unsuitable value type",
-				Detail:   "Unsuitable value: string required",
-				Subject:  attr.Expr.Range().Ptr(),
-			})
 		}
 	}
+	return &pr, diags
+}
 
-	return reqs, diags
+func decodeString(expr hcl.Expression, target *string) *hcl.Diagnostic {
+	val, diags := expr.Value(nil)
+	if diags.HasErrors() {
+		return unsuitableValueDiagnostic(expr)
+	}
+	if err := gocty.FromCtyValue(val, target); err != nil {
+		return unsuitableValueDiagnostic(expr)
+	}
+	return nil
+}
+
+func unsuitableValueDiagnostic(expr hcl.Expression) *hcl.Diagnostic {
+	return &hcl.Diagnostic{
+		Severity: hcl.DiagError,
+		Summary:  "Unsuitable value type",
+		Detail:   "Unsuitable value: string required",
+		Subject:  expr.Range().Ptr(),
+	}
 }
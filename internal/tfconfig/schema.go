@@ -22,6 +22,10 @@ var rootSchema = &hcl.BodySchema{
 			Type:       "provider",
 			LabelNames: []string{"name"},
 		},
+		{
+			Type:       "locals",
+			LabelNames: nil,
+		},
 		{
 			Type:       "resource",
 			LabelNames: []string{"type", "name"},
@@ -72,6 +76,30 @@ var variableSchema = &hcl.BodySchema{
 		{
 			Name: "default",
 		},
+		{
+			Name: "sensitive",
+		},
+		{
+			Name: "nullable",
+		},
+	},
+	Blocks: []hcl.BlockHeaderSchema{
+		{
+			Type: "validation",
+		},
+	},
+}
+
+var variableValidationSchema = &hcl.BodySchema{
+	Attributes: []hcl.AttributeSchema{
+		{
+			Name:     "condition",
+			Required: true,
+		},
+		{
+			Name:     "error_message",
+			Required: true,
+		},
 	},
 }
 
@@ -80,6 +108,9 @@ var outputSchema = &hcl.BodySchema{
 		{
 			Name: "description",
 		},
+		{
+			Name: "sensitive",
+		},
 	},
 }
 
@@ -14,6 +14,10 @@ import (
 type SourcePos struct {
 	Filename string `json:"filename"`
 	Line     int    `json:"line"`
+
+	// Column is only populated for the position of diagnostics, to point
+	// at the exact location of the described problem within the line.
+	Column int `json:"column,omitempty"`
 }
 
 //lint:ignore U1000 ignored because we pulled this from upstream
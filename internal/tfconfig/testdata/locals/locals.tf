@@ -0,0 +1,15 @@
+variable "name" {
+  default = "foo"
+}
+
+locals {
+  # Name prefix of all the resources
+  prefix = "${var.name}-prod"
+  tags = {
+    Name = local.prefix
+  }
+}
+
+locals {
+  enabled = true
+}
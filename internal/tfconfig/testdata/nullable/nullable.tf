@@ -0,0 +1,16 @@
+variable "nullable" {
+  type     = string
+  default  = "foo"
+  nullable = true
+}
+
+variable "non_nullable" {
+  type     = string
+  default  = "foo"
+  nullable = false
+}
+
+variable "unset" {
+  type    = string
+  default = "foo"
+}
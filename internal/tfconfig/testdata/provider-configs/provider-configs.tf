@@ -16,3 +16,16 @@ terraform {
     bar = "1.1.0"
   }
 }
+
+provider "foo" {
+  alias = "replica"
+}
+
+terraform {
+  required_providers {
+    qux = {
+      source                = "hashicorp/qux"
+      configuration_aliases = [qux.east, qux.west]
+    }
+  }
+}
@@ -0,0 +1,18 @@
+variable "password" {
+  type      = string
+  sensitive = true
+}
+
+variable "username" {
+  type      = string
+  sensitive = false
+}
+
+output "password" {
+  value     = var.password
+  sensitive = true
+}
+
+output "username" {
+  value = var.username
+}
@@ -0,0 +1,39 @@
+variable "no_validation" {
+  type = string
+}
+
+variable "single" {
+  type = string
+
+  validation {
+    condition     = length(var.single) > 4 && substr(var.single, 0, 4) == "ami-"
+    error_message = "The single value must be a valid AMI id, starting with \"ami-\"."
+  }
+}
+
+variable "multiple" {
+  type    = number
+  default = 1
+
+  validation {
+    condition     = var.multiple > 0
+    error_message = "The multiple value must be positive."
+  }
+
+  validation {
+    condition = contains(
+      [1, 2, 3],
+      var.multiple,
+    )
+    error_message = "The multiple value must be one of 1, 2 or 3."
+  }
+}
+
+variable "interpolated" {
+  type = string
+
+  validation {
+    condition     = contains(["dev", "prod"], var.interpolated)
+    error_message = "The interpolated value must be dev or prod, got ${var.interpolated}."
+  }
+}
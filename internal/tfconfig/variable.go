@@ -10,8 +10,32 @@ type Variable struct {
 	// the native Go type system. The conversion from the value given in
 	// configuration may be slightly lossy. Only values that can be
 	// serialized by json.Marshal will be included here.
-	Default  interface{} `json:"default"`
-	Required bool        `json:"required"`
+	Default interface{} `json:"default"`
+
+	// DefaultSource is the raw source of the default value, exactly as
+	// written in configuration.
+	DefaultSource string `json:"default_source,omitempty"`
+
+	Required  bool `json:"required"`
+	Sensitive bool `json:"sensitive,omitempty"`
+
+	// Nullable is the value of 'nullable' argument of the variable, or nil
+	// if it isn't declared, in which case null is a valid value of it.
+	Nullable *bool `json:"nullable,omitempty"`
+
+	// Validations are the custom validation rules of the variable, in
+	// the order they are declared in configuration.
+	Validations []*VariableValidation `json:"validations,omitempty"`
+
+	Pos SourcePos `json:"pos"`
+}
+
+// VariableValidation represents a single "validation" block within a
+// variable. The condition is kept as the raw source provided by the user,
+// since it can't be evaluated outside of Terraform.
+type VariableValidation struct {
+	Condition    string `json:"condition"`
+	ErrorMessage string `json:"error_message"`
 
 	Pos SourcePos `json:"pos"`
 }
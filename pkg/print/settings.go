@@ -2,6 +2,14 @@ package print
 
 // Settings represents all settings
 type Settings struct {
+	// DefaultHCL renders default values of inputs in HCL syntax instead of JSON (default: false)
+	// scope: Asciidoc, Markdown, Pretty
+	DefaultHCL bool
+
+	// DefaultSource renders default values of inputs as written in HCL source (default: false)
+	// scope: Asciidoc, Markdown, Pretty
+	DefaultSource bool
+
 	// EscapeCharacters escapes special characters (such as _ * in Markdown and > < in JSON) (default: true)
 	// scope: Markdown
 	EscapeCharacters bool
@@ -10,10 +18,25 @@ type Settings struct {
 	// scope: Markdown
 	EscapePipe bool
 
+	// Fragment render only the content of the page, without the surrounding
+	// html, head and body elements (default: false)
+	// scope: HTML
+	Fragment bool
+
 	// IndentLevel control the indentation of AsciiDoc and Markdown headers [available: 1, 2, 3, 4, 5] (default: 2)
 	// scope: Asciidoc, Markdown
 	IndentLevel int
 
+	// ModuleSource is the source of the module to call, e.g. in terraform block of
+	// Terragrunt configuration. Usage falls back to the local path of the module
+	// if it's empty (default: "")
+	// scope: Asciidoc, HTML, Markdown, reStructuredText, Terragrunt, Usage
+	ModuleSource string
+
+	// ModuleVersion is the version of the module to call, omitted if empty (default: "")
+	// scope: Asciidoc, HTML, Markdown, reStructuredText, Usage
+	ModuleVersion string
+
 	// OutputValues ailrghaekrgj
 	// scope: Global
 	OutputValues bool
@@ -22,6 +45,10 @@ type Settings struct {
 	// scope: Pretty
 	ShowColor bool
 
+	// ShowFooter show "Footer" module information (default: true)
+	// scope: Global
+	ShowFooter bool
+
 	// ShowHeader show "Header" module information (default: true)
 	// scope: Global
 	ShowHeader bool
@@ -30,6 +57,14 @@ type Settings struct {
 	// scope: Global
 	ShowInputs bool
 
+	// ShowLocals show "Locals" information (default: false)
+	// scope: Global
+	ShowLocals bool
+
+	// ShowModules show "Modules" information (default: true)
+	// scope: Global
+	ShowModules bool
+
 	// ShowOutputs show "Outputs" information (default: true)
 	// scope: Global
 	ShowOutputs bool
@@ -38,6 +73,10 @@ type Settings struct {
 	// scope: Global
 	ShowProviders bool
 
+	// ShowResources show "Resources" information (default: true)
+	// scope: Global
+	ShowResources bool
+
 	// ShowRequired show "Required" column when generating Markdown (default: true)
 	// scope: Markdown
 	ShowRequired bool
@@ -50,6 +89,10 @@ type Settings struct {
 	// scope: Global
 	ShowRequirements bool
 
+	// ShowUsage show "Usage" section with an example of calling the module (default: false)
+	// scope: Asciidoc, HTML, Markdown, reStructuredText
+	ShowUsage bool
+
 	// SortByName sorted rendering of inputs and outputs (default: true)
 	// scope: Global
 	SortByName bool
@@ -66,18 +109,28 @@ type Settings struct {
 // NewSettings returns new instance of Settings
 func NewSettings() *Settings {
 	return &Settings{
+		DefaultHCL:       false,
+		DefaultSource:    false,
 		EscapeCharacters: true,
 		EscapePipe:       true,
+		Fragment:         false,
 		IndentLevel:      2,
+		ModuleSource:     "",
+		ModuleVersion:    "",
 		OutputValues:     false,
 		ShowColor:        true,
+		ShowFooter:       true,
 		ShowHeader:       true,
 		ShowInputs:       true,
+		ShowLocals:       false,
+		ShowModules:      true,
 		ShowOutputs:      true,
 		ShowProviders:    true,
+		ShowResources:    true,
 		ShowRequired:     true,
 		ShowSensitivity:  true,
 		ShowRequirements: true,
+		ShowUsage:        false,
 		SortByName:       true,
 		SortByRequired:   false,
 		SortByType:       false,
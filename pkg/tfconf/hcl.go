@@ -0,0 +1,111 @@
+package tfconf
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var identifier = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`)
+
+// printHCL returns the canonical HCL representation of 'value', formatted the
+// same way as 'terraform fmt' does (e.g. objects are printed in multiple lines
+// and their attributes are aligned).
+func printHCL(value interface{}) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		panic(err)
+	}
+
+	// decode the JSON representation of the value again, to only deal with
+	// its primitive types (and not to lose precision of numbers).
+	var v interface{}
+	decoder := json.NewDecoder(&buf)
+	decoder.UseNumber()
+	if err := decoder.Decode(&v); err != nil {
+		panic(err)
+	}
+
+	const prefix = "value = "
+	formatted := hclwrite.Format([]byte(prefix + hclValue(v)))
+	return strings.TrimSpace(strings.TrimPrefix(string(formatted), prefix))
+}
+
+func hclValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		if v {
+			return "true"
+		}
+		return "false"
+	case json.Number:
+		return v.String()
+	case string:
+		return HCLString(v)
+	case []interface{}:
+		if len(v) == 0 {
+			return "[]"
+		}
+		items := make([]string, len(v))
+		inline := true
+		for i, item := range v {
+			items[i] = hclValue(item)
+			if isCollection(item) {
+				inline = false
+			}
+		}
+		if inline {
+			return "[" + strings.Join(items, ", ") + "]"
+		}
+		return "[\n" + strings.Join(items, ",\n") + ",\n]"
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return "{}"
+		}
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		var buf strings.Builder
+		buf.WriteString("{\n")
+		for _, key := range keys {
+			if !identifier.MatchString(key) {
+				buf.WriteString(HCLString(key))
+			} else {
+				buf.WriteString(key)
+			}
+			buf.WriteString(" = ")
+			buf.WriteString(hclValue(v[key]))
+			buf.WriteString("\n")
+		}
+		buf.WriteString("}")
+		return buf.String()
+	}
+	return "null"
+}
+
+// HCLString returns 's' as a quoted HCL string, with its special characters
+// escaped.
+func HCLString(s string) string {
+	return string(hclwrite.TokensForValue(cty.StringVal(s)).Bytes())
+}
+
+func isCollection(value interface{}) bool {
+	switch v := value.(type) {
+	case []interface{}:
+		return len(v) > 0
+	case map[string]interface{}:
+		return len(v) > 0
+	}
+	return false
+}
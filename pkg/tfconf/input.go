@@ -10,12 +10,25 @@ import (
 
 // Input represents a Terraform input.
 type Input struct {
-	Name        string       `json:"name" toml:"name" xml:"name" yaml:"name"`
-	Type        types.String `json:"type" toml:"type" xml:"type" yaml:"type"`
-	Description types.String `json:"description" toml:"description" xml:"description" yaml:"description"`
-	Default     types.Value  `json:"default" toml:"default" xml:"default" yaml:"default"`
-	Required    bool         `json:"required" toml:"required" xml:"required" yaml:"required"`
-	Position    Position     `json:"-" toml:"-" xml:"-" yaml:"-"`
+	Name          string        `json:"name" toml:"name" xml:"name" yaml:"name"`
+	Type          types.String  `json:"type" toml:"type" xml:"type" yaml:"type"`
+	TypeSource    string        `json:"-" toml:"-" xml:"-" yaml:"-"`
+	Structure     *Type         `json:"structure,omitempty" toml:"structure,omitempty" xml:"structure,omitempty" yaml:"structure,omitempty"`
+	Description   types.String  `json:"description" toml:"description" xml:"description" yaml:"description"`
+	Default       types.Value   `json:"default" toml:"default" xml:"default" yaml:"default"`
+	DefaultSource string        `json:"-" toml:"-" xml:"-" yaml:"-"`
+	Required      bool          `json:"required" toml:"required" xml:"required" yaml:"required"`
+	Sensitive     bool          `json:"sensitive" toml:"sensitive" xml:"sensitive" yaml:"sensitive"`
+	Nullable      bool          `json:"-" toml:"-" xml:"-" yaml:"-"`
+	Validations   []*Validation `json:"validations,omitempty" toml:"validations,omitempty" xml:"validations>validation,omitempty" yaml:"validations,omitempty"`
+	Tags          Tags          `json:"tags,omitempty" toml:"tags,omitempty" xml:"tags,omitempty" yaml:"tags,omitempty"`
+	Position      Position      `json:"-" toml:"-" xml:"-" yaml:"-"`
+}
+
+// Validation represents a custom validation rule of a Terraform input.
+type Validation struct {
+	Condition    string       `json:"condition" toml:"condition" xml:"condition" yaml:"condition"`
+	ErrorMessage types.String `json:"error_message" toml:"error_message" xml:"error_message" yaml:"error_message"`
 }
 
 // GetValue returns JSON representation of the 'Default' value, which is an 'interface'.
@@ -40,6 +53,36 @@ func (i *Input) GetValue() string {
 	return value // everything else
 }
 
+// HasValidations indicates if a Terraform variable has custom validation rules.
+func (i *Input) HasValidations() bool {
+	return len(i.Validations) > 0
+}
+
+// GetHCL returns canonical HCL representation of the 'Default' value, formatted
+// the same way as 'terraform fmt' does.
+func (i *Input) GetHCL() string {
+	if i.GetValue() == "" {
+		return ""
+	}
+	return printHCL(i.Default)
+}
+
+// GetSource returns the raw HCL source of the 'Default' value, exactly as written
+// in the configuration. If the source isn't available (e.g. for modules loaded with
+// the legacy loader) the JSON representation of it will be returned instead.
+func (i *Input) GetSource() string {
+	if i.DefaultSource == "" {
+		return i.GetValue()
+	}
+	return i.DefaultSource
+}
+
+// HasAttributes indicates if the type of a Terraform variable is an object type
+// (or a collection of object type) with attributes.
+func (i *Input) HasAttributes() bool {
+	return i.Structure.HasAttributes()
+}
+
 // HasDefault indicates if a Terraform variable has a default value set.
 func (i *Input) HasDefault() bool {
 	return i.Default.HasDefault() || !i.Required
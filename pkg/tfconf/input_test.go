@@ -199,3 +199,108 @@ func TestInputValue(t *testing.T) {
 		})
 	}
 }
+
+func TestInputSource(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Input
+		expected string
+	}{
+		{
+			name: "input without default",
+			input: Input{
+				Name:     "input",
+				Default:  types.ValueOf(nil),
+				Required: true,
+			},
+			expected: "",
+		},
+		{
+			name: "input with default source",
+			input: Input{
+				Name:          "input",
+				Default:       types.ValueOf(map[string]interface{}{"a": "b"}),
+				DefaultSource: "{ a = \"b\" }",
+				Required:      false,
+			},
+			expected: "{ a = \"b\" }",
+		},
+		{
+			name: "input without default source",
+			input: Input{
+				Name:     "input",
+				Default:  types.ValueOf(map[string]interface{}{"a": "b"}),
+				Required: false,
+			},
+			expected: "{\n  \"a\": \"b\"\n}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			assert.Equal(tt.expected, tt.input.GetSource())
+		})
+	}
+}
+
+func TestInputHCL(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    Input
+		expected string
+	}{
+		{
+			name:     "input without default",
+			input:    Input{Name: "input", Default: types.ValueOf(nil), Required: true},
+			expected: "",
+		},
+		{
+			name:     "input with null default",
+			input:    Input{Name: "input", Default: types.ValueOf(nil), Required: false},
+			expected: "null",
+		},
+		{
+			name:     "input with string default",
+			input:    Input{Name: "input", Default: types.ValueOf("foo \"${bar}\""), Required: false},
+			expected: `"foo \"$${bar}\""`,
+		},
+		{
+			name:     "input with number default",
+			input:    Input{Name: "input", Default: types.ValueOf(13.75), Required: false},
+			expected: "13.75",
+		},
+		{
+			name:     "input with list default",
+			input:    Input{Name: "input", Default: types.ValueOf([]interface{}{"a", true, 42}), Required: false},
+			expected: `["a", true, 42]`,
+		},
+		{
+			name:     "input with empty map default",
+			input:    Input{Name: "input", Default: types.ValueOf(map[string]interface{}{}), Required: false},
+			expected: "{}",
+		},
+		{
+			name: "input with map default",
+			input: Input{
+				Name: "input",
+				Default: types.ValueOf(map[string]interface{}{
+					"name":     "foo",
+					"tags":     map[string]interface{}{"env": "dev", "kubernetes.io/name": "foo"},
+					"ports":    []interface{}{80, 443},
+					"rules":    []interface{}{map[string]interface{}{"port": 80}},
+					"disabled": false,
+				}),
+				Required: false,
+			},
+			expected: "{\n  disabled = false\n  name     = \"foo\"\n  ports    = [80, 443]\n  rules = [\n    {\n      port = 80\n    },\n  ]\n  tags = {\n    env                  = \"dev\"\n    \"kubernetes.io/name\" = \"foo\"\n  }\n}",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			assert.Equal(tt.expected, tt.input.GetHCL())
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package tfconf
+
+import (
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+// Local represents a local value declared in Terraform module.
+type Local struct {
+	Name        string       `json:"name" toml:"name" xml:"name" yaml:"name"`
+	Description types.String `json:"description" toml:"description" xml:"description" yaml:"description"`
+	Expression  string       `json:"expression" toml:"expression" xml:"expression" yaml:"expression"`
+	Position    Position     `json:"-" toml:"-" xml:"-" yaml:"-"`
+}
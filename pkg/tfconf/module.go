@@ -9,17 +9,26 @@ import (
 // - Header       ('header' json key):    Module header found in shape of multi line comments at the beginning of 'main.tf'
 // - Inputs       ('inputs' json key):    List of input 'variables' extracted from the Terraform module .tf files
 // - Outputs      ('outputs' json key):   List of 'outputs' extracted from Terraform module .tf files
+// - Locals       ('locals' json key):    List of 'locals' values declared in Terraform module .tf files
 // - Providers    ('providers' json key): List of 'providers' extracted from resources used in Terraform module
+// - Modules      ('modules' json key):   List of 'modules' called by the Terraform module
+// - Resources    ('resources' json key): List of 'resources' and 'data' sources used in Terraform module
 // - Requirements ('header' json key):    List of 'requirements' extracted from the Terraform module .tf files
+// - Footer       ('footer' json key):    Module footer read from the file provided with '--footer-from', if any
 type Module struct {
 	XMLName xml.Name `json:"-" toml:"-" xml:"module" yaml:"-"`
 
 	Header       string         `json:"header" toml:"header" xml:"header" yaml:"header"`
 	Inputs       []*Input       `json:"inputs" toml:"inputs" xml:"inputs>input" yaml:"inputs"`
 	Outputs      []*Output      `json:"outputs" toml:"outputs" xml:"outputs>output" yaml:"outputs"`
+	Locals       []*Local       `json:"locals,omitempty" toml:"locals,omitempty" xml:"locals>local,omitempty" yaml:"locals,omitempty"`
 	Providers    []*Provider    `json:"providers" toml:"providers" xml:"providers>provider" yaml:"providers"`
+	Modules      []*ModuleCall  `json:"modules" toml:"modules" xml:"modules>module" yaml:"modules"`
+	Resources    []*Resource    `json:"resources" toml:"resources" xml:"resources>resource" yaml:"resources"`
 	Requirements []*Requirement `json:"requirements" toml:"requirements" xml:"requirements>requirement" yaml:"requirements"`
+	Footer       string         `json:"footer" toml:"footer" xml:"footer" yaml:"footer"`
 
+	Path           string   `json:"-" toml:"-" xml:"-" yaml:"-"`
 	RequiredInputs []*Input `json:"-" toml:"-" xml:"-" yaml:"-"`
 	OptionalInputs []*Input `json:"-" toml:"-" xml:"-" yaml:"-"`
 }
@@ -29,21 +38,61 @@ func (m *Module) HasHeader() bool {
 	return len(m.Header) > 0
 }
 
+// HasFooter indicates if the module has footer.
+func (m *Module) HasFooter() bool {
+	return len(m.Footer) > 0
+}
+
 // HasInputs indicates if the module has inputs.
 func (m *Module) HasInputs() bool {
 	return len(m.Inputs) > 0
 }
 
+// HasSensitiveInputs indicates if the module has any sensitive input.
+func (m *Module) HasSensitiveInputs() bool {
+	for _, i := range m.Inputs {
+		if i.Sensitive {
+			return true
+		}
+	}
+	return false
+}
+
 // HasOutputs indicates if the module has outputs.
 func (m *Module) HasOutputs() bool {
 	return len(m.Outputs) > 0
 }
 
+// HasSensitiveOutputs indicates if the module has any sensitive output.
+func (m *Module) HasSensitiveOutputs() bool {
+	for _, o := range m.Outputs {
+		if o.Sensitive {
+			return true
+		}
+	}
+	return false
+}
+
+// HasLocals indicates if the module has locals.
+func (m *Module) HasLocals() bool {
+	return len(m.Locals) > 0
+}
+
 // HasProviders indicates if the module has providers.
 func (m *Module) HasProviders() bool {
 	return len(m.Providers) > 0
 }
 
+// HasModules indicates if the module calls other modules.
+func (m *Module) HasModules() bool {
+	return len(m.Modules) > 0
+}
+
+// HasResources indicates if the module has resources.
+func (m *Module) HasResources() bool {
+	return len(m.Resources) > 0
+}
+
 // HasRequirements indicates if the module has requirements.
 func (m *Module) HasRequirements() bool {
 	return len(m.Requirements) > 0
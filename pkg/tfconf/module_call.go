@@ -0,0 +1,13 @@
+package tfconf
+
+import (
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+// ModuleCall represents a submodule called by Terraform module.
+type ModuleCall struct {
+	Name     string       `json:"name" toml:"name" xml:"name" yaml:"name"`
+	Source   string       `json:"source" toml:"source" xml:"source" yaml:"source"`
+	Version  types.String `json:"version" toml:"version" xml:"version" yaml:"version"`
+	Position Position     `json:"-" toml:"-" xml:"-" yaml:"-"`
+}
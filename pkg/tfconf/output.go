@@ -13,7 +13,8 @@ type Output struct {
 	Name        string       `json:"name" toml:"name" xml:"name" yaml:"name"`
 	Description types.String `json:"description" toml:"description" xml:"description" yaml:"description"`
 	Value       types.Value  `json:"value,omitempty" toml:"value,omitempty" xml:"value,omitempty" yaml:"value,omitempty"`
-	Sensitive   bool         `json:"sensitive,omitempty" toml:"sensitive,omitempty" xml:"sensitive,omitempty" yaml:"sensitive,omitempty"`
+	Sensitive   bool         `json:"sensitive" toml:"sensitive" xml:"sensitive" yaml:"sensitive"`
+	Tags        Tags         `json:"tags,omitempty" toml:"tags,omitempty" xml:"tags,omitempty" yaml:"tags,omitempty"`
 	Position    Position     `json:"-" toml:"-" xml:"-" yaml:"-"`
 	ShowValue   bool         `json:"-" toml:"-" xml:"-" yaml:"-"`
 }
@@ -23,6 +24,7 @@ type withvalue struct {
 	Description types.String `json:"description" toml:"description" xml:"description" yaml:"description"`
 	Value       types.Value  `json:"value" toml:"value" xml:"value" yaml:"value"`
 	Sensitive   bool         `json:"sensitive" toml:"sensitive" xml:"sensitive" yaml:"sensitive"`
+	Tags        Tags         `json:"tags,omitempty" toml:"tags,omitempty" xml:"tags,omitempty" yaml:"tags,omitempty"`
 	Position    Position     `json:"-" toml:"-" xml:"-" yaml:"-"`
 	ShowValue   bool         `json:"-" toml:"-" xml:"-" yaml:"-"`
 }
@@ -53,12 +55,12 @@ func (o *Output) HasDefault() bool {
 	return o.Value.HasDefault()
 }
 
-// MarshalJSON custom yaml marshal function to take
+// MarshalJSON custom json marshal function to take
 // '--output-values' flag into consideration. It means
-// if the flag is not set Value and Sensitive fields
-// are set to 'omitempty', otherwise if output values
-// are being shown 'omitempty' gets explicitly removed
-// to show even empty and false values.
+// if the flag is not set Value field is set to 'omitempty',
+// otherwise if output values are being shown 'omitempty'
+// gets explicitly removed to show even empty values.
+// Sensitive field is always shown.
 func (o *Output) MarshalJSON() ([]byte, error) {
 	fn := func(oo interface{}) ([]byte, error) {
 		buf := new(bytes.Buffer)
@@ -72,17 +74,14 @@ func (o *Output) MarshalJSON() ([]byte, error) {
 	if o.ShowValue {
 		return fn(withvalue(*o))
 	}
-	o.Value = nil       // explicitly make empty
-	o.Sensitive = false // explicitly make empty
-	return fn(*o)
+	return fn(o.withoutValue())
 }
 
 // MarshalXML custom xml marshal function to take
 // '--output-values' flag into consideration. It means
-// if the flag is not set Value and Sensitive fields
-// are set to 'omitempty', otherwise if output values
-// are being shown 'omitempty' gets explicitly removed
-// to show even empty and false values.
+// if the flag is not set Value field is omitted, otherwise
+// if output values are being shown it's explicitly added
+// to show even empty values. Sensitive field is always shown.
 func (o *Output) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	fn := func(v interface{}, name string) error {
 		return e.EncodeElement(v, xml.StartElement{Name: xml.Name{Local: name}})
@@ -94,23 +93,32 @@ func (o *Output) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
 	fn(o.Name, "name")               //nolint: errcheck
 	fn(o.Description, "description") //nolint: errcheck
 	if o.ShowValue {
-		fn(o.Value, "value")         //nolint: errcheck
-		fn(o.Sensitive, "sensitive") //nolint: errcheck
+		fn(o.Value, "value") //nolint: errcheck
+	}
+	fn(o.Sensitive, "sensitive") //nolint: errcheck
+	if len(o.Tags) > 0 {
+		fn(o.Tags, "tags") //nolint: errcheck
 	}
 	return e.EncodeToken(start.End())
 }
 
 // MarshalYAML custom yaml marshal function to take
 // '--output-values' flag into consideration. It means
-// if the flag is not set Value and Sensitive fields
-// are set to 'omitempty', otherwise if output values
-// are being shown 'omitempty' gets explicitly removed
-// to show even empty and false values.
+// if the flag is not set Value field is set to 'omitempty',
+// otherwise if output values are being shown 'omitempty'
+// gets explicitly removed to show even empty values.
+// Sensitive field is always shown.
 func (o *Output) MarshalYAML() (interface{}, error) {
 	if o.ShowValue {
 		return withvalue(*o), nil
 	}
-	o.Value = nil       // explicitly make empty
-	o.Sensitive = false // explicitly make empty
-	return *o, nil
+	return o.withoutValue(), nil
+}
+
+// withoutValue returns a copy of the output with its Value explicitly made
+// empty, without modifying the output itself.
+func (o *Output) withoutValue() Output {
+	copy := *o
+	copy.Value = nil
+	return copy
 }
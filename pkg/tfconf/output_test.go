@@ -117,7 +117,7 @@ func TestOutputMarshalJSON(t *testing.T) {
 		{
 			name:     "output marshal JSON",
 			output:   outputs[1],
-			expected: "{\"name\":\"output\",\"description\":\"description\"}\n",
+			expected: "{\"name\":\"output\",\"description\":\"description\",\"sensitive\":false}\n",
 		},
 		{
 			name:     "output marshal JSON",
@@ -137,7 +137,7 @@ func TestOutputMarshalJSON(t *testing.T) {
 		{
 			name:     "output marshal JSON",
 			output:   outputs[5],
-			expected: "{\"name\":\"output\",\"description\":\"description\"}\n",
+			expected: "{\"name\":\"output\",\"description\":\"description\",\"sensitive\":false}\n",
 		},
 		{
 			name:     "output marshal JSON",
@@ -169,6 +169,11 @@ func TestOutputMarshalJSON(t *testing.T) {
 			output:   outputs[11],
 			expected: "{\"name\":\"output\",\"description\":\"description\",\"value\":null,\"sensitive\":false}\n",
 		},
+		{
+			name:     "output marshal JSON",
+			output:   outputs[12],
+			expected: "{\"name\":\"output\",\"description\":\"description\",\"sensitive\":true}\n",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -196,7 +201,7 @@ func TestOutputMarshalXML(t *testing.T) {
 		{
 			name:     "output marshal XML",
 			output:   outputs[1],
-			expected: "<output><name>output</name><description>description</description></output>",
+			expected: "<output><name>output</name><description>description</description><sensitive>false</sensitive></output>",
 		},
 		{
 			name:     "output marshal XML",
@@ -216,7 +221,7 @@ func TestOutputMarshalXML(t *testing.T) {
 		{
 			name:     "output marshal XML",
 			output:   outputs[5],
-			expected: "<output><name>output</name><description>description</description></output>",
+			expected: "<output><name>output</name><description>description</description><sensitive>false</sensitive></output>",
 		},
 		{
 			name:     "output marshal XML",
@@ -248,6 +253,11 @@ func TestOutputMarshalXML(t *testing.T) {
 			output:   outputs[11],
 			expected: "<output><name>output</name><description>description</description><value xsi:nil=\"true\"></value><sensitive>false</sensitive></output>",
 		},
+		{
+			name:     "output marshal XML",
+			output:   outputs[12],
+			expected: "<output><name>output</name><description>description</description><sensitive>true</sensitive></output>",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -335,6 +345,11 @@ func TestOutputMarshalYAML(t *testing.T) {
 			output:   outputs[11],
 			expected: "tfconf.withvalue",
 		},
+		{
+			name:     "output marshal JSON",
+			output:   outputs[12],
+			expected: "tfconf.Output",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -347,6 +362,22 @@ func TestOutputMarshalYAML(t *testing.T) {
 	}
 }
 
+func TestOutputMarshalKeepsOutput(t *testing.T) {
+	assert := assert.New(t)
+	output := sampleOutputs()[12]
+
+	_, err := output.MarshalJSON()
+	assert.Nil(err)
+
+	actual, err := output.MarshalYAML()
+	assert.Nil(err)
+
+	assert.Equal(types.ValueOf("this should be hidden"), output.Value)
+	assert.True(output.Sensitive)
+	assert.Nil(actual.(Output).Value)
+	assert.True(actual.(Output).Sensitive)
+}
+
 func sampleOutputs() []Output {
 	name := "output"
 	description := types.String("description")
@@ -446,5 +477,13 @@ func sampleOutputs() []Output {
 			Position:    position,
 			ShowValue:   true,
 		},
+		{
+			Name:        name,
+			Description: description,
+			Value:       types.ValueOf("this should be hidden"),
+			Sensitive:   true,
+			Position:    position,
+			ShowValue:   false,
+		},
 	}
 }
@@ -1,7 +1,20 @@
 package tfconf
 
+import (
+	"fmt"
+)
+
 // Position represents position of Terraform input or output in a file.
 type Position struct {
-	Filename string `json:"-" toml:"-" xml:"-" yaml:"-"`
-	Line     int    `json:"-" toml:"-" xml:"-" yaml:"-"`
+	Filename string `json:"filename" toml:"filename" xml:"filename" yaml:"filename"`
+	Line     int    `json:"line" toml:"line" xml:"line" yaml:"line"`
+}
+
+// String returns the position as 'filename:line', or empty if the filename
+// is unknown.
+func (p Position) String() string {
+	if p.Filename == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", p.Filename, p.Line)
 }
@@ -6,11 +6,16 @@ import (
 	"github.com/terraform-docs/terraform-docs/internal/types"
 )
 
-// Provider represents a Terraform output.
+// Provider represents a provider used by Terraform module. Kind of the provider
+// is one of 'declared' (configured in the module), 'expected' (configuration
+// passed in by callers) or 'implied' (only used by resources).
 type Provider struct {
 	Name     string       `json:"name" toml:"name" xml:"name" yaml:"name"`
 	Alias    types.String `json:"alias" toml:"alias" xml:"alias" yaml:"alias"`
 	Version  types.String `json:"version" toml:"version" xml:"version" yaml:"version"`
+	Source   types.String `json:"source" toml:"source" xml:"source" yaml:"source"`
+	URL      types.String `json:"url" toml:"url" xml:"url" yaml:"url"`
+	Kind     string       `json:"kind" toml:"kind" xml:"kind" yaml:"kind"`
 	Position Position     `json:"-" toml:"-" xml:"-" yaml:"-"`
 }
 
@@ -8,4 +8,6 @@ import (
 type Requirement struct {
 	Name    string       `json:"name" xml:"name" yaml:"name"`
 	Version types.String `json:"version" xml:"version" yaml:"version"`
+	Source  types.String `json:"source" xml:"source" yaml:"source"`
+	URL     types.String `json:"url" xml:"url" yaml:"url"`
 }
@@ -0,0 +1,20 @@
+package tfconf
+
+import (
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+// Resource represents a managed resource or a data source used by Terraform module.
+type Resource struct {
+	Address  string       `json:"address" toml:"address" xml:"address" yaml:"address"`
+	Type     string       `json:"type" toml:"type" xml:"type" yaml:"type"`
+	Mode     string       `json:"mode" toml:"mode" xml:"mode" yaml:"mode"`
+	Provider string       `json:"provider" toml:"provider" xml:"provider" yaml:"provider"`
+	URL      types.String `json:"url" toml:"url" xml:"url" yaml:"url"`
+	Position Position     `json:"position" toml:"position" xml:"position" yaml:"position"`
+}
+
+// IsData indicates if the resource is a data source.
+func (r *Resource) IsData() bool {
+	return r.Mode == "data"
+}
@@ -0,0 +1,51 @@
+package tfconf
+
+import (
+	"encoding/xml"
+	"sort"
+)
+
+// Tags represents the annotations of a Terraform input or output, which are
+// declared as '@tag value' lines in the comments preceding its block or in its
+// description. For example:
+//
+//	# @deprecated use 'subnet_ids' instead
+//	# @since 2.3.0
+//	variable "subnet_id" {}
+type Tags map[string]string
+
+// Has indicates if the tag with given 'name' is declared, with or without value.
+func (t Tags) Has(name string) bool {
+	_, ok := t[name]
+	return ok
+}
+
+// Get returns the value of the tag with given 'name', or empty if it isn't
+// declared or doesn't have any value.
+func (t Tags) Get(name string) string {
+	return t[name]
+}
+
+// MarshalXML custom marshal function which converts tags to their literal
+// XML representation, sorted by their name. For example:
+//
+//	<tags>
+//	  <deprecated>use 'subnet_ids' instead</deprecated>
+//	  <since>2.3.0</since>
+//	</tags>
+func (t Tags) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	names := make([]string, 0, len(t))
+	for name := range t {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := e.EncodeElement(t[name], xml.StartElement{Name: xml.Name{Local: name}}); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
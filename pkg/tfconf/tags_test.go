@@ -0,0 +1,55 @@
+package tfconf
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTags(t *testing.T) {
+	tests := []struct {
+		name        string
+		tags        Tags
+		expectHas   bool
+		expectGet   string
+		expectedXML string
+	}{
+		{
+			name:        "nil tags",
+			tags:        nil,
+			expectHas:   false,
+			expectGet:   "",
+			expectedXML: "<tags></tags>",
+		},
+		{
+			name:        "tag without value",
+			tags:        Tags{"deprecated": ""},
+			expectHas:   true,
+			expectGet:   "",
+			expectedXML: "<tags><deprecated></deprecated></tags>",
+		},
+		{
+			name:        "tag with value",
+			tags:        Tags{"since": "2.3.0", "deprecated": "use 'foo' instead"},
+			expectHas:   true,
+			expectGet:   "use 'foo' instead",
+			expectedXML: "<tags><deprecated>use &#39;foo&#39; instead</deprecated><since>2.3.0</since></tags>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			assert.Equal(tt.expectHas, tt.tags.Has("deprecated"))
+			assert.Equal(tt.expectGet, tt.tags.Get("deprecated"))
+
+			actual, err := xml.Marshal(struct {
+				XMLName xml.Name `xml:"item"`
+				Tags    Tags     `xml:"tags"`
+			}{Tags: tt.tags})
+			assert.Nil(err)
+			assert.Equal("<item>"+tt.expectedXML+"</item>", string(actual))
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package tfconf
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/terraform-docs/terraform-docs/internal/types"
+)
+
+// Type represents the structure of a Terraform type constraint, e.g. a
+// primitive type (string, number, bool, any), a collection type (list, set,
+// map) of an element type or a structural type (tuple, object).
+type Type struct {
+	Kind       string           `json:"kind" toml:"kind" xml:"kind" yaml:"kind"`
+	Element    *Type            `json:"element,omitempty" toml:"element,omitempty" xml:"element,omitempty" yaml:"element,omitempty"`
+	Elements   []*Type          `json:"elements,omitempty" toml:"elements,omitempty" xml:"elements>element,omitempty" yaml:"elements,omitempty"`
+	Attributes []*TypeAttribute `json:"attributes,omitempty" toml:"attributes,omitempty" xml:"attributes>attribute,omitempty" yaml:"attributes,omitempty"`
+}
+
+// TypeAttribute represents an attribute of a Terraform object type. Optional
+// attributes are declared with 'optional()' modifier and their 'Default' is
+// the raw source of the default value, if any.
+type TypeAttribute struct {
+	Name        string       `json:"name" toml:"name" xml:"name" yaml:"name"`
+	Type        *Type        `json:"type" toml:"type" xml:"type" yaml:"type"`
+	Description types.String `json:"description" toml:"description" xml:"description" yaml:"description"`
+	Optional    bool         `json:"optional" toml:"optional" xml:"optional" yaml:"optional"`
+	Default     string       `json:"default,omitempty" toml:"default,omitempty" xml:"default,omitempty" yaml:"default,omitempty"`
+}
+
+// String returns the short name of the type, i.e. structural types are not
+// expanded. For example 'list(object)' for a list of objects.
+func (t *Type) String() string {
+	if t == nil {
+		return "any"
+	}
+	if t.Element != nil {
+		return fmt.Sprintf("%s(%s)", t.Kind, t.Element.String())
+	}
+	return t.Kind
+}
+
+// HasAttributes indicates if the type is an object type with attributes, or
+// a collection of such object type.
+func (t *Type) HasAttributes() bool {
+	return len(t.ObjectAttributes()) > 0
+}
+
+// ObjectAttributes returns the attributes of the type if it is an object type,
+// or of its element type if it is a collection of object type (e.g. 'list(object({...}))').
+func (t *Type) ObjectAttributes() []*TypeAttribute {
+	for t != nil {
+		if t.Kind == "object" {
+			return t.Attributes
+		}
+		t = t.Element
+	}
+	return nil
+}
+
+// MarshalXML custom xml marshal function which wraps elements and attributes
+// of the type in '<elements></elements>' and '<attributes></attributes>', and
+// omits the wrappers altogether if the type doesn't have any of them.
+func (t *Type) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	fn := func(v interface{}, name string) error {
+		return e.EncodeElement(v, xml.StartElement{Name: xml.Name{Local: name}})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+	if err := fn(t.Kind, "kind"); err != nil {
+		return err
+	}
+	if t.Element != nil {
+		if err := fn(t.Element, "element"); err != nil {
+			return err
+		}
+	}
+	if len(t.Elements) > 0 {
+		elements := struct {
+			Elements []*Type `xml:"element"`
+		}{t.Elements}
+		if err := fn(elements, "elements"); err != nil {
+			return err
+		}
+	}
+	if len(t.Attributes) > 0 {
+		attributes := struct {
+			Attributes []*TypeAttribute `xml:"attribute"`
+		}{t.Attributes}
+		if err := fn(attributes, "attributes"); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
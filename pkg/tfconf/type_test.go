@@ -0,0 +1,119 @@
+package tfconf
+
+import (
+	"bytes"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTypeString(t *testing.T) {
+	object := &Type{
+		Kind: "object",
+		Attributes: []*TypeAttribute{
+			{Name: "name", Type: &Type{Kind: "string"}},
+		},
+	}
+	tests := []struct {
+		name          string
+		typ           *Type
+		expected      string
+		hasAttributes bool
+	}{
+		{
+			name:          "nil type",
+			typ:           nil,
+			expected:      "any",
+			hasAttributes: false,
+		},
+		{
+			name:          "primitive type",
+			typ:           &Type{Kind: "number"},
+			expected:      "number",
+			hasAttributes: false,
+		},
+		{
+			name:          "collection type",
+			typ:           &Type{Kind: "map", Element: &Type{Kind: "string"}},
+			expected:      "map(string)",
+			hasAttributes: false,
+		},
+		{
+			name:          "tuple type",
+			typ:           &Type{Kind: "tuple", Elements: []*Type{{Kind: "string"}}},
+			expected:      "tuple",
+			hasAttributes: false,
+		},
+		{
+			name:          "object type",
+			typ:           object,
+			expected:      "object",
+			hasAttributes: true,
+		},
+		{
+			name:          "collection of object type",
+			typ:           &Type{Kind: "set", Element: &Type{Kind: "list", Element: object}},
+			expected:      "set(list(object))",
+			hasAttributes: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+
+			assert.Equal(tt.expected, tt.typ.String())
+			assert.Equal(tt.hasAttributes, tt.typ.HasAttributes())
+		})
+	}
+}
+
+func TestTypeMarshalXML(t *testing.T) {
+	tests := []struct {
+		name     string
+		typ      *Type
+		expected string
+	}{
+		{
+			name:     "type marshal XML of primitive type",
+			typ:      &Type{Kind: "string"},
+			expected: "<type><kind>string</kind></type>",
+		},
+		{
+			name:     "type marshal XML of collection type",
+			typ:      &Type{Kind: "list", Element: &Type{Kind: "number"}},
+			expected: "<type><kind>list</kind><element><kind>number</kind></element></type>",
+		},
+		{
+			name: "type marshal XML of tuple type",
+			typ: &Type{Kind: "tuple", Elements: []*Type{
+				{Kind: "string"},
+				{Kind: "bool"},
+			}},
+			expected: "<type><kind>tuple</kind><elements><element><kind>string</kind></element><element><kind>bool</kind></element></elements></type>",
+		},
+		{
+			name: "type marshal XML of object type",
+			typ: &Type{Kind: "object", Attributes: []*TypeAttribute{
+				{Name: "name", Type: &Type{Kind: "string"}},
+			}},
+			expected: "<type><kind>object</kind><attributes><attribute><name>name</name><type><kind>string</kind></type><description xsi:nil=\"true\"></description><optional>false</optional></attribute></attributes></type>",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			var b bytes.Buffer
+			encoder := xml.NewEncoder(&b)
+			start := xml.StartElement{Name: xml.Name{Local: "type"}}
+
+			err := tt.typ.MarshalXML(encoder, start)
+			assert.Nil(err)
+
+			err = encoder.Flush()
+			assert.Nil(err)
+
+			assert.Equal(tt.expected, b.String())
+		})
+	}
+}
@@ -95,6 +95,107 @@ func sanitizeItemForAsciidocTable(s string, settings *print.Settings) string {
 	return result
 }
 
+// sanitizeItemForRst converts passed 'string' to suitable reStructuredText
+// representation. (including inline literals, illegal characters, code blocks etc)
+func sanitizeItemForRst(s string, settings *print.Settings) string {
+	if s == "" {
+		return "n/a"
+	}
+	result := processSegments(
+		s,
+		"```",
+		func(segment string) string {
+			segment = processSegments(
+				segment,
+				"`",
+				func(segment string) string {
+					segment = escapeRstCharacters(segment, settings)
+					segment = convertRstLinks(segment)
+					segment = normalizeURLs(segment, settings)
+					return segment
+				},
+				func(segment string) string {
+					segment = fmt.Sprintf("``%s``", segment)
+					return segment
+				},
+			)
+			return segment
+		},
+		func(segment string) string {
+			segment = convertRstCodeBlock(segment)
+			return segment
+		},
+	)
+	result = regexp.MustCompile(`(\r?\n){3,}`).ReplaceAllString(result, "$1$1")
+	return strings.TrimSpace(result)
+}
+
+var (
+	rstBulletPattern     = regexp.MustCompile(`^(\s*)\*(\s+)`)
+	rstEmphasisPattern   = regexp.MustCompile(`(^|\s)(\*{1,2})([^\s*]|[^\s*][^*]*[^\s*])(\*{1,2})([\s.,;:!?)]|$)`)
+	rstUnderscorePattern = regexp.MustCompile(`_+(\W|$)`)
+	rstLinkPattern       = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+	rstLanguagePattern   = regexp.MustCompile(`^[\w+-]*$`)
+)
+
+// escapeRstCharacters escapes characters which have special meaning in reStructuredText
+// into their corresponding literal. Asterisks of bullet lists and (strong) emphasis are
+// kept as is, as they mean the same in Markdown, and underscore is only escaped at the end
+// of a word, where it would otherwise be taken as a hyperlink reference (e.g. 'foo_').
+func escapeRstCharacters(s string, settings *print.Settings) string {
+	if !settings.EscapeCharacters {
+		return s
+	}
+	const placeholder = "‡‡‡DONTESCAPE‡‡‡"
+	return executePerLine(s, func(line string) string {
+		line = strings.Replace(line, "\\", "\\\\", -1)
+		line = rstBulletPattern.ReplaceAllString(line, "$1"+placeholder+"$2")
+		line = rstEmphasisPattern.ReplaceAllStringFunc(line, func(m string) string {
+			match := rstEmphasisPattern.FindStringSubmatch(m)
+			if match[2] != match[4] {
+				return m
+			}
+			marker := strings.Repeat(placeholder, len(match[2]))
+			return match[1] + marker + match[3] + marker + match[5]
+		})
+		for _, char := range []string{"*", "`", "|"} {
+			line = strings.Replace(line, char, "\\"+char, -1)
+		}
+		line = rstUnderscorePattern.ReplaceAllStringFunc(line, func(m string) string {
+			return strings.Replace(m, "_", "\\_", -1)
+		})
+		return strings.Replace(line, placeholder, "*", -1)
+	})
+}
+
+// convertRstLinks converts Markdown links (e.g. '[text](url)') into anonymous
+// reStructuredText hyperlinks (e.g. '`text <url>`__').
+func convertRstLinks(s string) string {
+	return rstLinkPattern.ReplaceAllString(s, "`$1 <$2>`__")
+}
+
+// convertRstCodeBlock converts content of a Markdown fenced code block into
+// reStructuredText 'code-block' directive. The language of the block is read
+// from its first line and defaults to 'hcl'.
+func convertRstCodeBlock(s string) string {
+	language := "hcl"
+	lines := strings.Split(s, "\n")
+	if first := strings.TrimSpace(lines[0]); len(lines) > 1 && rstLanguagePattern.MatchString(first) {
+		if first != "" {
+			language = first
+		}
+		lines = lines[1:]
+	}
+	code := strings.Trim(strings.Join(lines, "\n"), "\r\n")
+	code = executePerLine(code, func(line string) string {
+		if strings.TrimSpace(line) == "" {
+			return ""
+		}
+		return "   " + line
+	})
+	return fmt.Sprintf("\n\n.. code-block:: %s\n\n%s\n\n", language, code)
+}
+
 // convertMultiLineText converts a multi-line text into a suitable Markdown representation.
 func convertMultiLineText(s string, isTable bool) string {
 	if isTable {
@@ -229,6 +229,48 @@ func TestSanitizeItemForAsciidocTable(t *testing.T) {
 	}
 }
 
+func TestSanitizeItemForRst(t *testing.T) {
+	tests := []struct {
+		name        string
+		filename    string
+		escapeChars bool
+	}{
+		{
+			name:        "sanitize rst item empty",
+			filename:    "empty",
+			escapeChars: true,
+		},
+		{
+			name:        "sanitize rst item complex",
+			filename:    "complex",
+			escapeChars: true,
+		},
+		{
+			name:        "sanitize rst item codeblock",
+			filename:    "codeblock",
+			escapeChars: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			settings := testutil.Settings().With(&print.Settings{
+				EscapeCharacters: tt.escapeChars,
+			}).Build()
+
+			bytes, err := ioutil.ReadFile(filepath.Join("testdata", "table", tt.filename+".golden"))
+			assert.Nil(err)
+
+			actual := sanitizeItemForRst(string(bytes), settings)
+
+			expected, err := ioutil.ReadFile(filepath.Join("testdata", "table", tt.filename+".rst.expected"))
+			assert.Nil(err)
+
+			assert.Equal(string(expected), actual)
+		})
+	}
+}
+
 func TestConvertMultiLineText(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -169,6 +169,9 @@ func builtinFuncs(settings *print.Settings) template.FuncMap {
 			settings.EscapePipe = false
 			return s
 		},
+		"sanitizeRst": func(s string) string {
+			return sanitizeItemForRst(s, settings)
+		},
 	}
 }
 
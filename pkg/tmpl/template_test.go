@@ -473,6 +473,32 @@ func TestBuiltinFunc(t *testing.T) {
 			escapePipe: true,
 			expected:   "n/a",
 		},
+
+		// sanitizeRst
+		{
+			name:       "template builtin functions sanitizeRst",
+			funcName:   "sanitizeRst",
+			funcArgs:   []string{"\"Example of 'foo_' module in `foo_bar.tf`.\n\n| Foo | *Bar* | 2 * 3 |\""},
+			escapeChar: true,
+			escapePipe: false,
+			expected:   "Example of 'foo\\_' module in ``foo_bar.tf``.\n\n\\| Foo \\| *Bar* \\| 2 \\* 3 \\|",
+		},
+		{
+			name:       "template builtin functions sanitizeRst",
+			funcName:   "sanitizeRst",
+			funcArgs:   []string{"\"Example of 'foo_' module in `foo_bar.tf`.\n\n| Foo | *Bar* | 2 * 3 |\""},
+			escapeChar: false,
+			escapePipe: false,
+			expected:   "Example of 'foo_' module in ``foo_bar.tf``.\n\n| Foo | *Bar* | 2 * 3 |",
+		},
+		{
+			name:       "template builtin functions sanitizeRst",
+			funcName:   "sanitizeRst",
+			funcArgs:   []string{`""`},
+			escapeChar: true,
+			escapePipe: false,
+			expected:   "n/a",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -26,6 +26,9 @@ import (
 var basedir = "/docs"
 var formatdir = "/formats"
 
+// exampleModuleSource is the source of the module in examples of formatters requiring it
+var exampleModuleSource = "git::https://example.com/modules.git//examples?ref=v1.0.0"
+
 func main() {
 	err := generate(cmd.NewCommand(), "", "FORMATS_GUIDE")
 	if err != nil {
@@ -134,6 +137,8 @@ func getFlags(name string) string {
 	switch strings.Replace(name, "terraform-docs ", "", -1) {
 	case "pretty":
 		return " --no-color"
+	case "terragrunt":
+		return fmt.Sprintf(" --module-source %q", exampleModuleSource)
 	}
 	return ""
 }
@@ -159,6 +164,9 @@ func printExample(buf *bytes.Buffer, name string) error {
 	}
 
 	name = strings.Replace(name, "terraform-docs ", "", -1)
+	if name == "terragrunt" {
+		settings.ModuleSource = exampleModuleSource
+	}
 	printer, err := format.Factory(name, settings)
 	if err != nil {
 		return err